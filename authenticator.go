@@ -4,11 +4,48 @@ import (
 	"net/http"
 )
 
-// Auth contains token to be sent with every request after authentication.
+// Authenticator adds credentials to every request sent to the API.
+type Authenticator interface {
+	SetAuth(r *http.Request)
+}
+
+// Auth contains IBSSO token to be sent with every request after authentication.
 type Auth struct {
 	Token string
 }
 
-func (a *Auth) setAuth(r *http.Request) {
-	r.Header.Add("Authorization", "IBSSO "+a.Token)
+// SetAuth is an implementation of Authenticator interface for the Auth type.
+func (a *Auth) SetAuth(r *http.Request) {
+	r.Header.Set("Authorization", "IBSSO "+a.Token)
+}
+
+// APIKeyAuth authenticates requests with an API key.
+type APIKeyAuth struct {
+	Key string
+}
+
+// SetAuth is an implementation of Authenticator interface for the APIKeyAuth type.
+func (a *APIKeyAuth) SetAuth(r *http.Request) {
+	r.Header.Set("Authorization", "App "+a.Key)
+}
+
+// BasicAuth authenticates requests with HTTP Basic credentials.
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// SetAuth is an implementation of Authenticator interface for the BasicAuth type.
+func (a *BasicAuth) SetAuth(r *http.Request) {
+	r.SetBasicAuth(a.Username, a.Password)
+}
+
+// BearerAuth authenticates requests with an OAuth2 access token.
+type BearerAuth struct {
+	Token string
+}
+
+// SetAuth is an implementation of Authenticator interface for the BearerAuth type.
+func (a *BearerAuth) SetAuth(r *http.Request) {
+	r.Header.Set("Authorization", "Bearer "+a.Token)
 }
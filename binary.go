@@ -0,0 +1,225 @@
+package infobip
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const binarySmsEndpoint = "/sms/1/binary/single"
+const multiBinarySmsEndpoint = "/sms/1/binary/multi"
+const advancedBinarySmsEndpoint = "/sms/1/binary/advanced"
+
+// maxBinarySegment is the maximum payload of a single binary message in bytes,
+// including the user data header when ESM class has the UDHI bit set.
+const maxBinarySegment = 140
+
+// Binary is the payload of a binary message.
+// "Hex" is the hex encoded content, bytes may be separated with spaces.
+// "DataCoding" is the data coding scheme, e.g. 4 for 8-bit data.
+// "EsmClass" is the ESM class, e.g. 64 when the content starts with a user data header.
+type Binary struct {
+	Hex        string `json:"hex"`
+	DataCoding int    `json:"dataCoding"`
+	EsmClass   int    `json:"esmClass"`
+}
+
+// NewBinary builds a binary payload from raw bytes.
+func NewBinary(data []byte, dataCoding, esmClass int) Binary {
+	return Binary{
+		Hex:        HexPayload(data),
+		DataCoding: dataCoding,
+		EsmClass:   esmClass,
+	}
+}
+
+// HexPayload encodes raw bytes as space separated hex, e.g. "0f c2 4a".
+func HexPayload(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Bytes decodes the hex content.
+func (b *Binary) Bytes() ([]byte, error) {
+	return hex.DecodeString(strings.Replace(b.Hex, " ", "", -1))
+}
+
+// Validate checks the content is valid hex fitting into a single message segment.
+func (b *Binary) Validate() error {
+	return b.validate("").errorOrNil()
+}
+
+func (b *Binary) validate(prefix string) ValidationErrors {
+	data, err := b.Bytes()
+	if err != nil {
+		return ValidationErrors{{Field: prefix + "binary.hex", Message: "content must be hex encoded"}}
+	}
+
+	if len(data) < 1 {
+		return ValidationErrors{{Field: prefix + "binary.hex", Message: "content must be specified"}}
+	}
+
+	if len(data) > maxBinarySegment {
+		return ValidationErrors{{
+			Field:   prefix + "binary.hex",
+			Message: fmt.Sprintf("content must be at most %d bytes long", maxBinarySegment),
+		}}
+	}
+
+	return nil
+}
+
+// BinarySMS is a binary message that will be sent to array of destination addresses.
+// "MessageID" is an optional caller-provided message ID, when set failed sends are
+// retried according to the client retry policy.
+type BinarySMS struct {
+	From      string   `json:"from"`
+	To        []string `json:"to"`
+	Binary    Binary   `json:"binary"`
+	MessageID string   `json:"messageId,omitempty"`
+}
+
+// Validate checks the message with DefaultValidationRules.
+// It returns ValidationErrors listing every violation.
+func (s *BinarySMS) Validate() error {
+	return s.validate(DefaultValidationRules, "").errorOrNil()
+}
+
+func (s *BinarySMS) validate(rules ValidationRules, prefix string) ValidationErrors {
+	var errs ValidationErrors
+
+	if msg := validateSender(s.From); len(msg) > 0 {
+		errs = append(errs, FieldError{Field: prefix + "from", Message: msg})
+	}
+
+	errs = append(errs, validateDestinations(s.To, rules, prefix)...)
+	errs = append(errs, s.Binary.validate(prefix)...)
+
+	return errs
+}
+
+// AdvancedBinaryMessage is a single message of the AdvancedBinarySMS request,
+// the options are the same as of AdvancedMessage.
+type AdvancedBinaryMessage struct {
+	From              string        `json:"from,omitempty"`
+	Destinations      []Destination `json:"destinations"`
+	Binary            Binary        `json:"binary"`
+	Flash             bool          `json:"flash,omitempty"`
+	NotifyURL         string        `json:"notifyUrl,omitempty"`
+	NotifyContentType string        `json:"notifyContentType,omitempty"`
+	CallbackData      string        `json:"callbackData,omitempty"`
+	ValidityPeriod    int           `json:"validityPeriod,omitempty"`
+	SendAt            *Time         `json:"sendAt,omitempty"`
+}
+
+// AdvancedBinarySMS is a request sending many binary messages, each with its own
+// content and destinations. "BulkID" is an optional caller-provided ID of the whole request.
+type AdvancedBinarySMS struct {
+	BulkID   string                  `json:"bulkId,omitempty"`
+	Messages []AdvancedBinaryMessage `json:"messages"`
+}
+
+func (m AdvancedBinaryMessage) destinations() []Destination {
+	return m.Destinations
+}
+
+// SendBinarySMS allows you to send a single binary message to array of destination addresses.
+func (c *Client) SendBinarySMS(sms *BinarySMS) (*SmsResponse, error) {
+	return c.SendBinarySMSContext(context.Background(), sms)
+}
+
+// SendBinarySMSContext is like SendBinarySMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendBinarySMSContext(ctx context.Context, sms *BinarySMS) (*SmsResponse, error) {
+
+	if !c.skipValidation {
+		if err := sms.validate(c.validationRules, "").errorOrNil(); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := jsonBuffer(sms)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, binarySmsEndpoint, payload, len(sms.MessageID) > 0)
+}
+
+// SendMultiBinarySMS allows you to send many binary messages, each with its own content,
+// in one request. All messages share the same bulk ID.
+func (c *Client) SendMultiBinarySMS(messages []BinarySMS) (*SmsResponse, error) {
+	return c.SendMultiBinarySMSContext(context.Background(), messages)
+}
+
+// SendMultiBinarySMSContext is like SendMultiBinarySMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendMultiBinarySMSContext(ctx context.Context, messages []BinarySMS) (*SmsResponse, error) {
+
+	if len(messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	// the whole batch is checked before anything is sent
+	var errs ValidationErrors
+	retryable := true
+	for i, sms := range messages {
+		if !c.skipValidation {
+			errs = append(errs, sms.validate(c.validationRules, fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(sms.MessageID) < 1 {
+			retryable = false
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	payload, err := jsonBuffer(struct {
+		Messages []BinarySMS `json:"messages"`
+	}{messages})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, multiBinarySmsEndpoint, payload, retryable)
+}
+
+// SendAdvancedBinarySMS allows you to send many binary messages in one request,
+// every message with its own content, destinations and delivery options.
+func (c *Client) SendAdvancedBinarySMS(sms *AdvancedBinarySMS) (*SmsResponse, error) {
+	return c.SendAdvancedBinarySMSContext(context.Background(), sms)
+}
+
+// SendAdvancedBinarySMSContext is like SendAdvancedBinarySMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendAdvancedBinarySMSContext(ctx context.Context, sms *AdvancedBinarySMS) (*SmsResponse, error) {
+
+	if len(sms.Messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	if !c.skipValidation {
+		var errs ValidationErrors
+		for i, m := range sms.Messages {
+			errs = append(errs, m.Binary.validate(fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(errs) > 0 {
+			return nil, errs
+		}
+	}
+
+	payload, err := jsonBuffer(sms)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, advancedBinarySmsEndpoint, payload, hasMessageIDs(sms.Messages))
+}
@@ -0,0 +1,87 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestBinaryPayload(t *testing.T) {
+	binary := infobip.NewBinary([]byte{0x0f, 0xc2, 0x4a}, 4, 0)
+	if binary.Hex != "0f c2 4a" {
+		t.Fatalf("unexpected hex: %s", binary.Hex)
+	}
+
+	data, err := binary.Bytes()
+	if err != nil || len(data) != 3 {
+		t.Fatalf("unexpected content %v: %v", data, err)
+	}
+
+	if err := binary.Validate(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	tooLong := infobip.NewBinary(make([]byte, 141), 4, 0)
+	if err := tooLong.Validate(); !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	invalid := infobip.Binary{Hex: "zz"}
+	if err := invalid.Validate(); err == nil {
+		t.Fatal("Should fail on invalid hex")
+	}
+}
+
+func TestSendBinarySMS(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var payload map[string]interface{}
+	respond := func(w http.ResponseWriter, r *http.Request) {
+		payload = map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("sms-sent-response.json"))
+	}
+	mux.HandleFunc("/sms/1/binary/single", respond)
+	mux.HandleFunc("/sms/1/binary/multi", respond)
+	mux.HandleFunc("/sms/1/binary/advanced", respond)
+
+	sms := infobip.BinarySMS{
+		From:   "InfoSMS",
+		To:     []string{"+12125551234"},
+		Binary: infobip.NewBinary([]byte("hello"), 4, 0),
+	}
+
+	if _, err := client.SendBinarySMS(&sms); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	binary := payload["binary"].(map[string]interface{})
+	if binary["hex"] != "68 65 6c 6c 6f" || binary["dataCoding"] != 4.0 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+
+	if _, err := client.SendMultiBinarySMS([]infobip.BinarySMS{sms, sms}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(payload["messages"].([]interface{})) != 2 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+
+	_, err := client.SendAdvancedBinarySMS(&infobip.AdvancedBinarySMS{
+		Messages: []infobip.AdvancedBinaryMessage{
+			{
+				Destinations: []infobip.Destination{{To: "12125551234"}},
+				Binary:       infobip.Binary{Hex: "not hex"},
+			},
+		},
+	})
+	if !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
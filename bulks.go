@@ -0,0 +1,169 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const bulksEndpoint = "/sms/1/bulks"
+const bulksStatusEndpoint = "/sms/1/bulks/status"
+
+// BulkStatus is the sending status of a scheduled bulk.
+type BulkStatus string
+
+// Scheduled bulk statuses.
+const (
+	BulkPending    BulkStatus = "PENDING"
+	BulkPaused     BulkStatus = "PAUSED"
+	BulkProcessing BulkStatus = "PROCESSING"
+	BulkCanceled   BulkStatus = "CANCELED"
+	BulkFinished   BulkStatus = "FINISHED"
+	BulkFailed     BulkStatus = "FAILED"
+)
+
+// ScheduledBulk contains the time a scheduled bulk will be sent at.
+type ScheduledBulk struct {
+	BulkID string
+	SendAt time.Time
+}
+
+// scheduledBulk is the wire format of ScheduledBulk.
+type scheduledBulk struct {
+	BulkID string `json:"bulkId,omitempty"`
+	SendAt Time   `json:"sendAt"`
+}
+
+// BulkStatusInfo contains the sending status of a scheduled bulk.
+type BulkStatusInfo struct {
+	BulkID string     `json:"bulkId,omitempty"`
+	Status BulkStatus `json:"status"`
+}
+
+// GetScheduledBulk allows you to get the time a scheduled bulk will be sent at.
+func (c *Client) GetScheduledBulk(bulkID string) (*ScheduledBulk, error) {
+	return c.GetScheduledBulkContext(context.Background(), bulkID)
+}
+
+// GetScheduledBulkContext is like GetScheduledBulk but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetScheduledBulkContext(ctx context.Context, bulkID string) (*ScheduledBulk, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	res := scheduledBulk{}
+	err := c.doRequest(ctx, "GET", c.baseURL+bulksEndpoint+bulkQuery(bulkID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.parse(), nil
+}
+
+// RescheduleBulk allows you to change the time a scheduled bulk will be sent at.
+func (c *Client) RescheduleBulk(bulkID string, sendAt time.Time) (*ScheduledBulk, error) {
+	return c.RescheduleBulkContext(context.Background(), bulkID, sendAt)
+}
+
+// RescheduleBulkContext is like RescheduleBulk but uses the given context
+// for the underlying HTTP request.
+func (c *Client) RescheduleBulkContext(ctx context.Context, bulkID string, sendAt time.Time) (*ScheduledBulk, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	if sendAt.IsZero() {
+		return nil, errors.New("send time must be specified")
+	}
+
+	data, err := json.Marshal(scheduledBulk{SendAt: Time{sendAt}})
+	if err != nil {
+		return nil, err
+	}
+
+	res := scheduledBulk{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+bulksEndpoint+bulkQuery(bulkID), bytes.NewBuffer(data), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.parse(), nil
+}
+
+// GetBulkStatus allows you to get the sending status of a scheduled bulk.
+func (c *Client) GetBulkStatus(bulkID string) (*BulkStatusInfo, error) {
+	return c.GetBulkStatusContext(context.Background(), bulkID)
+}
+
+// GetBulkStatusContext is like GetBulkStatus but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetBulkStatusContext(ctx context.Context, bulkID string) (*BulkStatusInfo, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	res := BulkStatusInfo{}
+	err := c.doRequest(ctx, "GET", c.baseURL+bulksStatusEndpoint+bulkQuery(bulkID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateBulkStatus allows you to pause, resume or cancel sending of a scheduled bulk.
+// Status must be one of BulkPaused, BulkProcessing or BulkCanceled.
+func (c *Client) UpdateBulkStatus(bulkID string, status BulkStatus) (*BulkStatusInfo, error) {
+	return c.UpdateBulkStatusContext(context.Background(), bulkID, status)
+}
+
+// UpdateBulkStatusContext is like UpdateBulkStatus but uses the given context
+// for the underlying HTTP request.
+func (c *Client) UpdateBulkStatusContext(ctx context.Context, bulkID string, status BulkStatus) (*BulkStatusInfo, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	if !status.isSettable() {
+		return nil, errors.Errorf("bulk status can't be changed to %q", status)
+	}
+
+	data, err := json.Marshal(BulkStatusInfo{Status: status})
+	if err != nil {
+		return nil, err
+	}
+
+	res := BulkStatusInfo{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+bulksStatusEndpoint+bulkQuery(bulkID), bytes.NewBuffer(data), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// isSettable reports whether a scheduled bulk can be switched to the status.
+func (s BulkStatus) isSettable() bool {
+	switch s {
+	case BulkPaused, BulkProcessing, BulkCanceled:
+		return true
+	}
+	return false
+}
+
+func bulkQuery(bulkID string) string {
+	return "?" + url.Values{"bulkId": {bulkID}}.Encode()
+}
+
+func (b *scheduledBulk) parse() *ScheduledBulk {
+	return &ScheduledBulk{BulkID: b.BulkID, SendAt: b.SendAt.Time}
+}
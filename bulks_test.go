@@ -0,0 +1,91 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestScheduledBulk(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var sendAt string
+	mux.HandleFunc("/sms/1/bulks", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("bulkId") != "BULK-ID-123-xyz" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if r.Method == "PUT" {
+			body := map[string]string{}
+			json.NewDecoder(r.Body).Decode(&body)
+			sendAt = body["sendAt"]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("scheduled-bulk-response.json"))
+	})
+
+	bulk, err := client.GetScheduledBulk("BULK-ID-123-xyz")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := time.Date(2015, 7, 7, 16, 0, 0, 0, time.UTC)
+	if !bulk.SendAt.Equal(expected) {
+		t.Fatalf("unexpected send time: %s", bulk.SendAt)
+	}
+
+	at := time.Date(2015, 7, 7, 17, 0, 0, 0, time.FixedZone("CET", 3600))
+	if _, err := client.RescheduleBulk("BULK-ID-123-xyz", at); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if sendAt != "2015-07-07T17:00:00.000+0100" {
+		t.Fatalf("unexpected send time format: %s", sendAt)
+	}
+
+	if _, err := client.RescheduleBulk("BULK-ID-123-xyz", time.Time{}); err == nil {
+		t.Fatal("Should fail without send time")
+	}
+}
+
+func TestBulkStatus(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	status := infobip.BulkPaused
+	mux.HandleFunc("/sms/1/bulks/status", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			body := infobip.BulkStatusInfo{}
+			json.NewDecoder(r.Body).Decode(&body)
+			status = body.Status
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"bulkId": "%s", "status": "%s"}`, r.URL.Query().Get("bulkId"), status)
+	})
+
+	res, err := client.GetBulkStatus("BULK-ID-123-xyz")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.Status != infobip.BulkPaused {
+		t.Fatalf("unexpected status: %+v", res)
+	}
+
+	res, err = client.UpdateBulkStatus("BULK-ID-123-xyz", infobip.BulkCanceled)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.Status != infobip.BulkCanceled || res.BulkID != "BULK-ID-123-xyz" {
+		t.Fatalf("unexpected status: %+v", res)
+	}
+
+	if _, err := client.UpdateBulkStatus("BULK-ID-123-xyz", infobip.BulkFinished); err == nil {
+		t.Fatal("Should fail on status which can't be set")
+	}
+}
@@ -2,22 +2,49 @@ package infobip
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/pkg/errors"
 	"io"
 	"net/http"
+	"sync"
+	"time"
 )
 
 const reportsEndpoint = "/sms/1/reports"
 const smsEndpoint = "/sms/1/text/single"
+const multiSmsEndpoint = "/sms/1/text/multi"
+const advancedSmsEndpoint = "/sms/2/text/advanced"
 const sessionEndpoint = "/auth/1/session"
 
 const apiURL = "https://api.infobip.com"
 
+const defaultUserAgent = "go-infobip/0.1"
+
+const jsonContentType = "application/json"
+
 // Client is the top-level client.
 type Client struct {
-	authenticator Auth
+	authenticator Authenticator
 	baseURL       string
+	httpClient    *http.Client
+	userAgent     string
+	retryPolicy   RetryPolicy
+
+	limiters             map[EndpointFamily]*tokenBucket
+	rateLimitNonBlocking bool
+
+	validationRules ValidationRules
+	skipValidation  bool
+
+	// authMu guards authenticator and session credentials,
+	// renewMu makes sure only one session renewal runs at a time
+	authMu        sync.RWMutex
+	renewMu       sync.Mutex
+	renewSessions bool
+	username      string
+	password      string
 }
 
 // Option is a functional option for configuring the API client
@@ -31,6 +58,112 @@ func BaseURL(baseURL string) Option {
 	}
 }
 
+// WithHTTPClient allows to use a custom http.Client for all API calls
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("http client must not be nil")
+		}
+		c.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTimeout sets the overall timeout of every HTTP call made by the client
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) error {
+		if timeout < 0 {
+			return errors.New("timeout must not be negative")
+		}
+		// work on a copy to keep a client passed with WithHTTPClient untouched
+		httpClient := *c.httpClient
+		httpClient.Timeout = timeout
+		c.httpClient = &httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the http.RoundTripper used to make HTTP calls,
+// e.g. for custom TLS or proxy settings
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) error {
+		if transport == nil {
+			return errors.New("transport must not be nil")
+		}
+		// work on a copy to keep a client passed with WithHTTPClient untouched
+		httpClient := *c.httpClient
+		httpClient.Transport = transport
+		c.httpClient = &httpClient
+		return nil
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) error {
+		if len(userAgent) < 1 {
+			return errors.New("user agent must not be empty")
+		}
+		c.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithAuthenticator sets the Authenticator used to sign every request
+func WithAuthenticator(authenticator Authenticator) Option {
+	return func(c *Client) error {
+		if authenticator == nil {
+			return errors.New("authenticator must not be nil")
+		}
+		c.setAuthenticator(authenticator)
+		return nil
+	}
+}
+
+// WithAPIKey authenticates every request with the given API key
+func WithAPIKey(key string) Option {
+	return func(c *Client) error {
+		if len(key) < 1 {
+			return errors.New("api key must be specified")
+		}
+		c.setAuthenticator(&APIKeyAuth{Key: key})
+		return nil
+	}
+}
+
+// WithBasicAuth authenticates every request with HTTP Basic credentials
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) error {
+		if len(username) < 1 || len(password) < 1 {
+			return errors.New("username and password must be specified")
+		}
+		c.setAuthenticator(&BasicAuth{Username: username, Password: password})
+		return nil
+	}
+}
+
+// WithIBSSOToken authenticates every request with an existing IBSSO session token
+func WithIBSSOToken(token string) Option {
+	return func(c *Client) error {
+		if len(token) < 1 {
+			return errors.New("token must be specified")
+		}
+		c.setAuthenticator(&Auth{Token: token})
+		return nil
+	}
+}
+
+// WithBearerToken authenticates every request with an OAuth2 access token
+func WithBearerToken(token string) Option {
+	return func(c *Client) error {
+		if len(token) < 1 {
+			return errors.New("token must be specified")
+		}
+		c.setAuthenticator(&BearerAuth{Token: token})
+		return nil
+	}
+}
+
 // parseOptions parses the supplied options functions and returns a configured
 // *Client instance
 func (c *Client) parseOptions(opts ...Option) error {
@@ -51,7 +184,13 @@ func (c *Client) parseOptions(opts ...Option) error {
 func New(opts ...Option) (*Client, error) {
 
 	client := &Client{
-		baseURL: apiURL,
+		baseURL:    apiURL,
+		httpClient: &http.Client{},
+		userAgent:  defaultUserAgent,
+		retryPolicy: RetryPolicy{
+			MaxAttempts: 1,
+		},
+		validationRules: DefaultValidationRules,
 	}
 
 	if err := client.parseOptions(opts...); err != nil {
@@ -62,13 +201,14 @@ func New(opts ...Option) (*Client, error) {
 }
 
 // NewClient is the constructor for the Client.
-func NewClient(username, password string) (*Client, error) {
+// It authenticates with the given credentials, any options are applied before that.
+func NewClient(username, password string, opts ...Option) (*Client, error) {
 
 	if len(username) < 1 || len(password) < 1 {
 		return nil, errors.New("username and password must be specified")
 	}
 
-	client, err := New()
+	client, err := New(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,31 +221,142 @@ func NewClient(username, password string) (*Client, error) {
 	return client, nil
 }
 
-func (c *Client) doRequest(method string, path string, payload io.Reader, result interface{}) error {
+func (c *Client) doRequest(ctx context.Context, method string, path string, payload io.Reader, result interface{}) error {
+	return c.doRetryableRequest(ctx, method, path, payload, result, isIdempotent(method))
+}
+
+// doRetryableRequest sends the request and, when retryable is set,
+// repeats it on transient failures according to the client retry policy.
+func (c *Client) doRetryableRequest(ctx context.Context, method string, path string, payload io.Reader, result interface{}, retryable bool) error {
+	return c.doRawRequest(ctx, method, path, jsonContentType, payload, result, retryable)
+}
+
+// doRawRequest is like doRetryableRequest but sends the payload with the given content type.
+// The response is always decoded from JSON.
+func (c *Client) doRawRequest(ctx context.Context, method string, path string, contentType string, payload io.Reader, result interface{}, retryable bool) error {
 
-	req, err := http.NewRequest(method, path, payload)
+	req, err := c.newRequest(ctx, method, path, contentType, payload)
 	if err != nil {
 		return err
 	}
 
-	if len(c.authenticator.Token) > 0 {
-		c.authenticator.setAuth(req)
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			attemptReq, err = rewindRequest(req)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := c.waitRateLimit(ctx, attemptReq); err != nil {
+			return err
+		}
+
+		err = c.send(attemptReq, result)
+		if !retryable || !c.retryPolicy.shouldRetry(attempt, err) {
+			return err
+		}
+
+		if err := sleep(ctx, c.retryPolicy.backoff(attempt, err)); err != nil {
+			return err
+		}
+	}
+}
+
+// send makes a single call to the API, an expired IBSSO session
+// is renewed and the call is repeated once.
+func (c *Client) send(req *http.Request, result interface{}) error {
+
+	auth := c.currentAuthenticator()
+	err := c.roundTrip(req, auth, result)
+	if IsUnauthorized(err) && c.canRenewSession(auth) {
+		// the session has expired, log in again and repeat the request once
+		if err := c.renewSession(req.Context(), auth); err != nil {
+			return err
+		}
+
+		req, err = rewindRequest(req)
+		if err != nil {
+			return err
+		}
+
+		return c.roundTrip(req, c.currentAuthenticator(), result)
 	}
 
-	req.Header.Add("Content-Type", "application/json")
+	return err
+}
+
+// jsonBuffer returns the JSON encoding of v as request payload.
+func jsonBuffer(v interface{}) (*bytes.Buffer, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewBuffer(b), nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method string, path string, contentType string, payload io.Reader) (*http.Request, error) {
+
+	req, err := http.NewRequestWithContext(ctx, method, path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
 	req.Header.Add("Cache-Control", "no-cache")
-	req.Header.Add("User-Agent", "go-infobip/0.1")
+	req.Header.Add("User-Agent", c.userAgent)
+
+	return req, nil
+}
+
+// rewindRequest returns a copy of the request with a fresh body,
+// so the request can be sent once again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+
+	clone := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errors.New("request body can't be sent again")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone.Body = body
 
-	httpClient := &http.Client{}
-	resp, err := httpClient.Do(req)
+	return clone, nil
+}
+
+// roundTrip signs the request with the given authenticator, sends it and
+// decodes the response into result. A nil result discards the response body.
+func (c *Client) roundTrip(req *http.Request, auth Authenticator, result interface{}) error {
+
+	if auth != nil {
+		auth.SetAuth(req)
+	}
+
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		resp.Body.Close()
+		// report cancellation as the plain context error so callers
+		// can match it against context.Canceled or context.DeadlineExceeded
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return ctxErr
+		}
 		return err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode > 299 {
-		return errors.New(resp.Status)
+		return newAPIError(resp)
+	}
+
+	if result == nil {
+		return nil
 	}
 
 	err = json.NewDecoder(resp.Body).Decode(result)
@@ -115,6 +366,12 @@ func (c *Client) doRequest(method string, path string, payload io.Reader, result
 
 // Authenticate allows you to get access token.
 func (c *Client) Authenticate(username, password string) error {
+	return c.AuthenticateContext(context.Background(), username, password)
+}
+
+// AuthenticateContext is like Authenticate but uses the given context
+// for the underlying HTTP request.
+func (c *Client) AuthenticateContext(ctx context.Context, username, password string) error {
 
 	if len(username) < 1 || len(password) < 1 {
 		return errors.New("username and password must be specified")
@@ -128,22 +385,56 @@ func (c *Client) Authenticate(username, password string) error {
 		return err
 	}
 
+	req, err := c.newRequest(ctx, "POST", c.baseURL+sessionEndpoint, jsonContentType, bytes.NewBuffer(data))
+	if err != nil {
+		return err
+	}
+
+	// session is created without any previous credentials
 	res := Auth{}
-	err = c.doRequest("POST", c.baseURL+sessionEndpoint, bytes.NewBuffer(data), &res)
+	err = c.roundTrip(req, nil, &res)
 	if err != nil {
 		return err
 	}
 
-	c.authenticator = res
+	c.setSession(&res, username, password)
 
 	return nil
 }
 
 // GetDeliveryReport allows you to get one time delivery reports for sent SMS.
 func (c *Client) GetDeliveryReport(smsID string) (*SmsReportResponse, error) {
+	return c.GetDeliveryReportContext(context.Background(), smsID)
+}
+
+// GetDeliveryReportContext is like GetDeliveryReport but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetDeliveryReportContext(ctx context.Context, smsID string) (*SmsReportResponse, error) {
+
+	if len(smsID) < 1 {
+		return nil, errors.New("message ID must be specified")
+	}
+
+	return c.GetDeliveryReportsContext(ctx, DeliveryReportQuery{MessageID: smsID})
+}
+
+// GetDeliveryReports allows you to get one time delivery reports matching the query.
+// Every report is returned only once, so polling with an empty query
+// returns reports for all messages sent since the previous call.
+func (c *Client) GetDeliveryReports(query DeliveryReportQuery) (*SmsReportResponse, error) {
+	return c.GetDeliveryReportsContext(context.Background(), query)
+}
+
+// GetDeliveryReportsContext is like GetDeliveryReports but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetDeliveryReportsContext(ctx context.Context, query DeliveryReportQuery) (*SmsReportResponse, error) {
+
+	if query.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
 
 	res := SmsReportResponse{}
-	err := c.doRequest("GET", c.baseURL+reportsEndpoint+"?messageId="+smsID, nil, &res)
+	err := c.doRequest(ctx, "GET", c.baseURL+reportsEndpoint+query.encode(), nil, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -151,11 +442,13 @@ func (c *Client) GetDeliveryReport(smsID string) (*SmsReportResponse, error) {
 	return &res, nil
 }
 
-// SendSMS allows you to send a single textual message to array of destination addresses.
-func (c *Client) SendSMS(sms *SMS) (*SmsResponse, error) {
+// sendMessages posts the messages to the endpoint and checks that they are accepted.
+// Messages with their own IDs won't be sent twice, so the request is retried
+// when retryable is set.
+func (c *Client) sendMessages(ctx context.Context, endpoint string, payload io.Reader, retryable bool) (*SmsResponse, error) {
 
 	res := SmsResponse{}
-	err := c.doRequest("POST", c.baseURL+smsEndpoint, sms.buffer(), &res)
+	err := c.doRetryableRequest(ctx, "POST", c.baseURL+endpoint, payload, &res, retryable)
 	if err != nil {
 		return nil, err
 	}
@@ -166,3 +459,83 @@ func (c *Client) SendSMS(sms *SMS) (*SmsResponse, error) {
 
 	return &res, nil
 }
+
+// SendSMS allows you to send a single textual message to array of destination addresses.
+func (c *Client) SendSMS(sms *SMS) (*SmsResponse, error) {
+	return c.SendSMSContext(context.Background(), sms)
+}
+
+// SendSMSContext is like SendSMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendSMSContext(ctx context.Context, sms *SMS) (*SmsResponse, error) {
+
+	if !c.skipValidation {
+		if err := sms.ValidateWith(c.validationRules); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.sendMessages(ctx, smsEndpoint, sms.buffer(), len(sms.MessageID) > 0)
+}
+
+// SendAdvancedSMS allows you to send many messages in one request,
+// every message with its own text, destinations and delivery options.
+func (c *Client) SendAdvancedSMS(sms *AdvancedSMS) (*SmsResponse, error) {
+	return c.SendAdvancedSMSContext(context.Background(), sms)
+}
+
+// SendAdvancedSMSContext is like SendAdvancedSMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendAdvancedSMSContext(ctx context.Context, sms *AdvancedSMS) (*SmsResponse, error) {
+
+	if len(sms.Messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	// the whole batch is checked before anything is sent
+	if !c.skipValidation {
+		var errs ValidationErrors
+		for i, m := range sms.Messages {
+			errs = append(errs, m.validate(c.validationRules, fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(errs) > 0 {
+			return nil, errs
+		}
+	}
+
+	return c.sendMessages(ctx, advancedSmsEndpoint, sms.buffer(), hasMessageIDs(sms.Messages))
+}
+
+// SendMultiSMS allows you to send many textual messages, each with its own text,
+// in one request. All messages share the same bulk ID.
+func (c *Client) SendMultiSMS(messages []SMS) (*SmsResponse, error) {
+	return c.SendMultiSMSContext(context.Background(), messages)
+}
+
+// SendMultiSMSContext is like SendMultiSMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendMultiSMSContext(ctx context.Context, messages []SMS) (*SmsResponse, error) {
+
+	if len(messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	// the whole batch is checked before anything is sent
+	var errs ValidationErrors
+	retryable := true
+	for i, sms := range messages {
+		if !c.skipValidation {
+			errs = append(errs, sms.validate(c.validationRules, fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(sms.MessageID) < 1 {
+			retryable = false
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	payload := multiSMS{Messages: messages}
+	return c.sendMessages(ctx, multiSmsEndpoint, payload.buffer(), retryable)
+}
@@ -1,6 +1,7 @@
 package infobip_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/gaart/go-infobip"
 	"io/ioutil"
@@ -41,6 +42,20 @@ func setup() func() {
 		fmt.Fprint(w, fixture("sms-sent-response.json"))
 	})
 
+	// fake advanced sms sending endpoint
+	mux.HandleFunc("/sms/2/text/advanced", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, fixture("advanced-sms-sent-response.json"))
+	})
+
+	// fake multi sms sending endpoint
+	mux.HandleFunc("/sms/1/text/multi", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, fixture("multi-sms-sent-response.json"))
+	})
+
 	// fake delivery report endpoint
 	mux.HandleFunc("/sms/1/reports", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -96,7 +111,7 @@ func TestSmsClientOnFakeAPI(t *testing.T) {
 	}
 
 	sms := infobip.SMS{
-		From: "from somebody",
+		From: "somebody",
 		To:   []string{testPhoneNumber},
 		Text: "some message",
 	}
@@ -132,6 +147,19 @@ func TestSmsClientOnFakeAPI(t *testing.T) {
 	if deliveryResults.Results[0].Price.PricePerMessage.String() != "1.23" {
 		t.Fatalf("Price parsing failed")
 	}
+
+	report := deliveryResults.Results[0]
+	if !report.SentAt.Equal(time.Date(2015, 2, 12, 8, 58, 20, 323000000, time.UTC)) {
+		t.Fatalf("SentAt parsing failed: %s", report.SentAt)
+	}
+
+	if !report.Status.IsFinal() || !report.Status.IsSuccess() || !report.Error.IsSuccess() {
+		t.Fatalf("Status parsing failed: %+v", report.Status)
+	}
+
+	if res.Messages[0].Status.IsFinal() || res.Messages[0].Status.GroupName != infobip.StatusPending {
+		t.Fatalf("Status parsing failed: %+v", res.Messages[0].Status)
+	}
 }
 
 func TestSmsClientOnRealAPI(t *testing.T) {
@@ -158,7 +186,7 @@ func TestSmsClientOnRealAPI(t *testing.T) {
 	}
 
 	sms := infobip.SMS{
-		From: "from somebody",
+		From: "somebody",
 		To:   []string{testPhoneNumber},
 		Text: "some message",
 	}
@@ -194,3 +222,208 @@ func TestSmsClientOnRealAPI(t *testing.T) {
 	}
 
 }
+
+func TestSendSMSContextCanceled(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	sms := infobip.SMS{
+		From: "somebody",
+		To:   []string{"+12125551234"},
+		Text: "some message",
+	}
+
+	_, err := client.SendSMSContext(ctx, &sms)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+type recordingTransport struct {
+	requests  int
+	userAgent string
+}
+
+func (t *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests++
+	t.userAgent = r.Header.Get("User-Agent")
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+func TestTransportOptions(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	transport := &recordingTransport{}
+	c, err := infobip.New(
+		infobip.BaseURL(server.URL),
+		infobip.WithHTTPClient(&http.Client{}),
+		infobip.WithTimeout(time.Second),
+		infobip.WithTransport(transport),
+		infobip.WithUserAgent("test-agent/1.0"),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetDeliveryReport("1"); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	if transport.requests != 2 {
+		t.Fatalf("custom transport was not used: %d requests", transport.requests)
+	}
+
+	if transport.userAgent != "test-agent/1.0" {
+		t.Fatalf("unexpected user agent: %s", transport.userAgent)
+	}
+
+	if _, err := infobip.New(infobip.WithHTTPClient(nil)); err == nil {
+		t.Fatal("Should fail with nil http client")
+	}
+}
+
+func TestAuthenticationModes(t *testing.T) {
+	var authorization string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	}))
+	defer s.Close()
+
+	cases := []struct {
+		option   infobip.Option
+		expected string
+	}{
+		{infobip.WithAPIKey("secret"), "App secret"},
+		{infobip.WithBasicAuth("user", "pass"), "Basic dXNlcjpwYXNz"},
+		{infobip.WithIBSSOToken("token"), "IBSSO token"},
+		{infobip.WithBearerToken("token"), "Bearer token"},
+	}
+
+	for _, tc := range cases {
+		c, err := infobip.New(infobip.BaseURL(s.URL), tc.option)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if _, err := c.GetDeliveryReport("1"); err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if authorization != tc.expected {
+			t.Fatalf("expected %q, got %q", tc.expected, authorization)
+		}
+	}
+}
+
+func TestSendAdvancedSMS(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	sendAt := infobip.Time{Time: time.Now().Add(time.Hour)}
+	sms := infobip.AdvancedSMS{
+		BulkID: "my-bulk",
+		Messages: []infobip.AdvancedMessage{
+			{
+				From: "sender",
+				Destinations: []infobip.Destination{
+					{To: "41793026727", MessageID: "my-message-1"},
+				},
+				Text:      "first message",
+				NotifyURL: "https://example.com/reports",
+				SendAt:    &sendAt,
+			},
+			{
+				From: "sender",
+				Destinations: []infobip.Destination{
+					{To: "41793026834", MessageID: "my-message-2"},
+				},
+				Text:     "second message",
+				Language: &infobip.Language{LanguageCode: "TR"},
+			},
+		},
+	}
+
+	res, err := client.SendAdvancedSMS(&sms)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res.Messages) != 2 || res.Messages[1].MessageID != "my-message-2" {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	if _, err := client.SendAdvancedSMS(&infobip.AdvancedSMS{}); err == nil {
+		t.Fatal("Should fail without messages")
+	}
+}
+
+func TestSendMultiSMS(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	messages := []infobip.SMS{
+		{From: "sender", To: []string{"41793026727"}, Text: "Dear Alice"},
+		{From: "sender", To: []string{"41793026834"}, Text: "Dear Bob"},
+	}
+
+	res, err := client.SendMultiSMS(messages)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res.BulkID) < 1 || len(res.Messages) != 2 {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	messages = append(messages, infobip.SMS{From: "sender", Text: "nobody"})
+	if _, err := client.SendMultiSMS(messages); err == nil {
+		t.Fatal("Should fail on message without destinations")
+	}
+}
+
+func TestGetDeliveryReports(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	}))
+	defer s.Close()
+
+	c, err := infobip.New(infobip.BaseURL(s.URL))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	cases := []struct {
+		query    infobip.DeliveryReportQuery
+		expected string
+	}{
+		{infobip.DeliveryReportQuery{}, ""},
+		{infobip.DeliveryReportQuery{Limit: 100}, "limit=100"},
+		{infobip.DeliveryReportQuery{BulkID: "a&b", MessageID: "c d"}, "bulkId=a%26b&messageId=c+d"},
+	}
+
+	for _, tc := range cases {
+		res, err := c.GetDeliveryReports(tc.query)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if len(res.Results) != 1 {
+			t.Fatalf("unexpected response: %+v", res)
+		}
+
+		if query != tc.expected {
+			t.Fatalf("expected query %q, got %q", tc.expected, query)
+		}
+	}
+}
@@ -1,6 +1,11 @@
 package infobip
 
 import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -22,12 +27,12 @@ func (a *Amount) UnmarshalJSON(data []byte) error {
 // SentSmsStatus indicates whether the message is successfully sent,
 // not sent, delivered, not delivered, waiting for delivery or any other possible status.
 type SentSmsStatus struct {
-	GroupID     int    `json:"groupId"`
-	GroupName   string `json:"groupName"`
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Action      string `json:"action"`
+	GroupID     int         `json:"groupId"`
+	GroupName   StatusGroup `json:"groupName"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Action      string      `json:"action"`
 }
 
 // SentSmsPrice is a Sent SMS price info: amount and currency.
@@ -38,20 +43,20 @@ type SentSmsPrice struct {
 
 // SentSmsError indicates whether the error occurred during the query execution.
 type SentSmsError struct {
-	GroupID     int    `json:"groupId"`
-	GroupName   string `json:"groupName"`
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Permanent   bool   `json:"permanent"`
+	GroupID     int        `json:"groupId"`
+	GroupName   ErrorGroup `json:"groupName"`
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Permanent   bool       `json:"permanent"`
 }
 
 // SentSmsReport is a message-specific delivery report.
 type SentSmsReport struct {
 	BulkID    string        `json:"bulkId"`
 	To        string        `json:"to"`
-	SentAt    string        `json:"sentAt"`
-	DoneAt    string        `json:"doneAt"`
+	SentAt    Time          `json:"sentAt"`
+	DoneAt    Time          `json:"doneAt"`
 	Status    SentSmsStatus `json:"status"`
 	SmsCount  int           `json:"smsCount"`
 	MessageID string        `json:"messageId"`
@@ -64,3 +69,37 @@ type SentSmsReport struct {
 type SmsReportResponse struct {
 	Results []SentSmsReport `json:"results"`
 }
+
+// DeliveryReportQuery filters delivery reports.
+// Empty query returns all reports not fetched yet, "Limit" caps the number of returned reports.
+type DeliveryReportQuery struct {
+	BulkID    string
+	MessageID string
+	Limit     int
+}
+
+// encode returns URL-encoded query string, including the leading "?" when not empty.
+func (q *DeliveryReportQuery) encode() string {
+	v := url.Values{}
+	if len(q.BulkID) > 0 {
+		v.Set("bulkId", q.BulkID)
+	}
+	if len(q.MessageID) > 0 {
+		v.Set("messageId", q.MessageID)
+	}
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	if len(v) < 1 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// NewDeliveryReportHandler returns an http.Handler receiving delivery reports
+// pushed to the notifyUrl of sent messages. The callback is called once per report,
+// a failed push is sent again, so the callback should tolerate duplicate reports.
+func NewDeliveryReportHandler(callback func(context.Context, SentSmsReport) error, opts ...WebhookOption) http.Handler {
+	return newWebhook(callback, opts...)
+}
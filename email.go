@@ -0,0 +1,242 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const emailEndpoint = "/email/2/send"
+
+// Attachment is a file sent with an email, the content is read when the email is sent.
+type Attachment struct {
+	Name   string
+	Reader io.Reader
+}
+
+// Email is an email message that will be sent.
+// "Text" and "HTML" are the message bodies, or "TemplateID" selects a stored template.
+// "InlineImages" can be referenced from HTML by their names.
+// "Track", "TrackClicks" and "TrackOpens" override the account tracking settings when set.
+// "MessageID" is an optional caller-provided message ID, when set failed sends are
+// retried according to the client retry policy.
+type Email struct {
+	From               string
+	To                 []string
+	Cc                 []string
+	Bcc                []string
+	ReplyTo            string
+	Subject            string
+	Text               string
+	HTML               string
+	TemplateID         int
+	Attachments        []Attachment
+	InlineImages       []Attachment
+	IntermediateReport bool
+	NotifyURL          string
+	NotifyContentType  string
+	CallbackData       string
+	Track              *bool
+	TrackClicks        *bool
+	TrackOpens         *bool
+	TrackingURL        string
+	BulkID             string
+	MessageID          string
+	SendAt             *Time
+}
+
+// EmailResponseDetails contains info about every sent email.
+type EmailResponseDetails struct {
+	To           string            `json:"to"`
+	MessageCount int               `json:"messageCount"`
+	MessageID    string            `json:"messageId"`
+	Status       SmsResponseStatus `json:"status"`
+}
+
+// EmailResponse contains an array of sent email objects, one object per every destination.
+type EmailResponse struct {
+	BulkID   string                 `json:"bulkId"`
+	Messages []EmailResponseDetails `json:"messages"`
+}
+
+// Validate checks the email has a sender, destination, subject and content.
+// It returns ValidationErrors listing every violation.
+func (e *Email) Validate() error {
+	var errs ValidationErrors
+
+	if len(e.From) < 1 {
+		errs = append(errs, FieldError{Field: "from", Message: "sender must be specified"})
+	}
+
+	if len(e.To) < 1 {
+		errs = append(errs, FieldError{Field: "to", Message: "at least one destination must be specified"})
+	}
+
+	if e.TemplateID < 1 {
+		if len(e.Subject) < 1 {
+			errs = append(errs, FieldError{Field: "subject", Message: "subject must be specified"})
+		}
+		if len(e.Text) < 1 && len(e.HTML) < 1 {
+			errs = append(errs, FieldError{Field: "text", Message: "text, HTML or template must be specified"})
+		}
+	}
+
+	for i, a := range e.Attachments {
+		if len(a.Name) < 1 || a.Reader == nil {
+			errs = append(errs, FieldError{Field: "attachment[" + strconv.Itoa(i) + "]", Message: "name and content must be specified"})
+		}
+	}
+
+	for i, a := range e.InlineImages {
+		if len(a.Name) < 1 || a.Reader == nil {
+			errs = append(errs, FieldError{Field: "inlineImage[" + strconv.Itoa(i) + "]", Message: "name and content must be specified"})
+		}
+	}
+
+	return errs.errorOrNil()
+}
+
+// formField is a single value of a multipart form.
+type formField struct {
+	name  string
+	value string
+}
+
+// multipart encodes the email as multipart/form-data, it returns the body and its content type.
+func (e *Email) multipart() (*bytes.Buffer, string, error) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	fields := []formField{
+		{"from", e.From},
+		{"replyTo", e.ReplyTo},
+		{"subject", e.Subject},
+		{"text", e.Text},
+		{"html", e.HTML},
+		{"notifyUrl", e.NotifyURL},
+		{"notifyContentType", e.NotifyContentType},
+		{"callbackData", e.CallbackData},
+		{"trackingUrl", e.TrackingURL},
+		{"bulkId", e.BulkID},
+		{"messageId", e.MessageID},
+	}
+
+	for _, to := range e.To {
+		fields = append(fields, formField{"to", to})
+	}
+	for _, cc := range e.Cc {
+		fields = append(fields, formField{"cc", cc})
+	}
+	for _, bcc := range e.Bcc {
+		fields = append(fields, formField{"bcc", bcc})
+	}
+
+	for _, f := range fields {
+		if len(f.value) < 1 {
+			continue
+		}
+		if err := w.WriteField(f.name, f.value); err != nil {
+			return nil, "", err
+		}
+	}
+
+	flags := []struct {
+		name  string
+		value *bool
+	}{
+		{"track", e.Track},
+		{"trackClicks", e.TrackClicks},
+		{"trackOpens", e.TrackOpens},
+	}
+	for _, f := range flags {
+		if f.value == nil {
+			continue
+		}
+		if err := w.WriteField(f.name, strconv.FormatBool(*f.value)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if e.IntermediateReport {
+		if err := w.WriteField("intermediateReport", "true"); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if e.TemplateID > 0 {
+		if err := w.WriteField("templateId", strconv.Itoa(e.TemplateID)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if e.SendAt != nil && !e.SendAt.IsZero() {
+		if err := w.WriteField("sendAt", e.SendAt.Format(timeLayout)); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writeFiles(w, "attachment", e.Attachments); err != nil {
+		return nil, "", err
+	}
+
+	if err := writeFiles(w, "inlineImage", e.InlineImages); err != nil {
+		return nil, "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, w.FormDataContentType(), nil
+}
+
+func writeFiles(w *multipart.Writer, field string, files []Attachment) error {
+	for _, f := range files {
+		part, err := w.CreateFormFile(field, f.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, f.Reader); err != nil {
+			return errors.Wrapf(err, "couldn't read %s", f.Name)
+		}
+	}
+	return nil
+}
+
+// SendEmail allows you to send an email with attachments to array of destination addresses.
+func (c *Client) SendEmail(email *Email) (*EmailResponse, error) {
+	return c.SendEmailContext(context.Background(), email)
+}
+
+// SendEmailContext is like SendEmail but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendEmailContext(ctx context.Context, email *Email) (*EmailResponse, error) {
+
+	if !c.skipValidation {
+		if err := email.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	// attachments are read into memory, so the request can be repeated
+	body, contentType, err := email.multipart()
+	if err != nil {
+		return nil, err
+	}
+
+	res := EmailResponse{}
+	err = c.doRawRequest(ctx, "POST", c.baseURL+emailEndpoint, contentType, body, &res, len(email.MessageID) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Messages) < 1 {
+		return nil, errors.Errorf("Couldn't send an email: %+v", res)
+	}
+
+	return &res, nil
+}
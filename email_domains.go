@@ -0,0 +1,207 @@
+package infobip
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const emailValidationEndpoint = "/email/2/validation"
+const emailDomainsEndpoint = "/email/1/domains"
+
+// EmailValidation is the result of an email address validation.
+// "ValidMailbox" is "true", "false" or "unknown" when the mailbox can't be checked.
+// "DidYouMean" suggests a correction of a mistyped address.
+type EmailValidation struct {
+	To           string `json:"to"`
+	ValidMailbox string `json:"validMailbox"`
+	ValidSyntax  bool   `json:"validSyntax"`
+	CatchAll     bool   `json:"catchAll"`
+	DidYouMean   string `json:"didYouMean"`
+	Disposable   bool   `json:"disposable"`
+	RoleBased    bool   `json:"roleBased"`
+	Reason       string `json:"reason"`
+}
+
+// DomainTracking contains tracking settings of a sending domain.
+type DomainTracking struct {
+	Clicks      bool `json:"clicks"`
+	Opens       bool `json:"opens"`
+	Unsubscribe bool `json:"unsubscribe"`
+}
+
+// DNSRecord is a DNS record to publish for a sending domain.
+// "Verified" reports whether the record has been found in DNS.
+type DNSRecord struct {
+	RecordType    string `json:"recordType"`
+	Name          string `json:"name"`
+	ExpectedValue string `json:"expectedValue"`
+	Verified      bool   `json:"verified"`
+}
+
+// EmailDomain is a domain emails are sent from.
+type EmailDomain struct {
+	DomainID   int64          `json:"domainId"`
+	DomainName string         `json:"domainName"`
+	Active     bool           `json:"active"`
+	Tracking   DomainTracking `json:"tracking"`
+	DNSRecords []DNSRecord    `json:"dnsRecords"`
+	Blocked    bool           `json:"blocked"`
+	CreatedAt  Time           `json:"createdAt"`
+}
+
+// EmailDomainsResponse contains a collection of sending domains.
+type EmailDomainsResponse struct {
+	Results []EmailDomain `json:"results"`
+}
+
+// ValidateEmailAddress allows you to check the email address before sending emails to it.
+func (c *Client) ValidateEmailAddress(address string) (*EmailValidation, error) {
+	return c.ValidateEmailAddressContext(context.Background(), address)
+}
+
+// ValidateEmailAddressContext is like ValidateEmailAddress but uses the given context
+// for the underlying HTTP request.
+func (c *Client) ValidateEmailAddressContext(ctx context.Context, address string) (*EmailValidation, error) {
+
+	if len(address) < 1 {
+		return nil, errors.New("email address must be specified")
+	}
+
+	payload, err := jsonBuffer(map[string]string{"to": address})
+	if err != nil {
+		return nil, err
+	}
+
+	// validation doesn't change anything, so it's safe to retry
+	res := EmailValidation{}
+	err = c.doRetryableRequest(ctx, "POST", c.baseURL+emailValidationEndpoint, payload, &res, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func emailDomainPath(domainName string) string {
+	return emailDomainsEndpoint + "/" + url.PathEscape(domainName)
+}
+
+// GetEmailDomains allows you to get all sending domains of the account.
+func (c *Client) GetEmailDomains() (*EmailDomainsResponse, error) {
+	return c.GetEmailDomainsContext(context.Background())
+}
+
+// GetEmailDomainsContext is like GetEmailDomains but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailDomainsContext(ctx context.Context) (*EmailDomainsResponse, error) {
+
+	res := EmailDomainsResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailDomainsEndpoint, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// AddEmailDomain allows you to add a sending domain, the returned
+// DNS records must be published before the domain is verified.
+func (c *Client) AddEmailDomain(domainName string) (*EmailDomain, error) {
+	return c.AddEmailDomainContext(context.Background(), domainName)
+}
+
+// AddEmailDomainContext is like AddEmailDomain but uses the given context
+// for the underlying HTTP request.
+func (c *Client) AddEmailDomainContext(ctx context.Context, domainName string) (*EmailDomain, error) {
+
+	if len(domainName) < 1 {
+		return nil, errors.New("domain name must be specified")
+	}
+
+	payload, err := jsonBuffer(map[string]string{"domainName": domainName})
+	if err != nil {
+		return nil, err
+	}
+
+	res := EmailDomain{}
+	err = c.doRequest(ctx, "POST", c.baseURL+emailDomainsEndpoint, payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetEmailDomain allows you to get a sending domain.
+func (c *Client) GetEmailDomain(domainName string) (*EmailDomain, error) {
+	return c.GetEmailDomainContext(context.Background(), domainName)
+}
+
+// GetEmailDomainContext is like GetEmailDomain but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailDomainContext(ctx context.Context, domainName string) (*EmailDomain, error) {
+
+	if len(domainName) < 1 {
+		return nil, errors.New("domain name must be specified")
+	}
+
+	res := EmailDomain{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailDomainPath(domainName), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetEmailDomainDNSRecords allows you to get the DNS records to publish for a sending domain.
+func (c *Client) GetEmailDomainDNSRecords(domainName string) ([]DNSRecord, error) {
+	return c.GetEmailDomainDNSRecordsContext(context.Background(), domainName)
+}
+
+// GetEmailDomainDNSRecordsContext is like GetEmailDomainDNSRecords but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailDomainDNSRecordsContext(ctx context.Context, domainName string) ([]DNSRecord, error) {
+
+	domain, err := c.GetEmailDomainContext(ctx, domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.DNSRecords, nil
+}
+
+// VerifyEmailDomain allows you to start verification of the published DNS records
+// of a sending domain. The result is available with GetEmailDomain later.
+func (c *Client) VerifyEmailDomain(domainName string) error {
+	return c.VerifyEmailDomainContext(context.Background(), domainName)
+}
+
+// VerifyEmailDomainContext is like VerifyEmailDomain but uses the given context
+// for the underlying HTTP request.
+func (c *Client) VerifyEmailDomainContext(ctx context.Context, domainName string) error {
+
+	if len(domainName) < 1 {
+		return errors.New("domain name must be specified")
+	}
+
+	return c.doRequest(ctx, "POST", c.baseURL+emailDomainPath(domainName)+"/verify", nil, nil)
+}
+
+// DeleteEmailDomain allows you to delete a sending domain.
+func (c *Client) DeleteEmailDomain(domainName string) error {
+	return c.DeleteEmailDomainContext(context.Background(), domainName)
+}
+
+// DeleteEmailDomainContext is like DeleteEmailDomain but uses the given context
+// for the underlying HTTP request.
+func (c *Client) DeleteEmailDomainContext(ctx context.Context, domainName string) error {
+
+	if len(domainName) < 1 {
+		return errors.New("domain name must be specified")
+	}
+
+	return c.doRequest(ctx, "DELETE", c.baseURL+emailDomainPath(domainName), nil, nil)
+}
@@ -0,0 +1,98 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestValidateEmailAddress(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/email/2/validation", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"to": "%s", "validMailbox": "true", "validSyntax": true, "catchAll": false, "didYouMean": "", "disposable": false, "roleBased": true, "reason": ""}`, body["to"])
+	})
+
+	res, err := client.ValidateEmailAddress("info@example.com")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.To != "info@example.com" || res.ValidMailbox != "true" || !res.RoleBased || res.Disposable {
+		t.Fatalf("unexpected validation: %+v", res)
+	}
+}
+
+func TestEmailDomains(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var calls []string
+	mux.HandleFunc("/email/1/domains", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "POST" {
+			fmt.Fprint(w, fixture("email-domain-response.json"))
+			return
+		}
+		fmt.Fprintf(w, `{"results": [%s]}`, fixture("email-domain-response.json"))
+	})
+
+	mux.HandleFunc("/email/1/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("email-domain-response.json"))
+	})
+
+	mux.HandleFunc("/email/1/domains/example.com/verify", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	domain, err := client.AddEmailDomain("example.com")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if domain.DomainName != "example.com" || domain.CreatedAt.IsZero() {
+		t.Fatalf("unexpected domain: %+v", domain)
+	}
+
+	domains, err := client.GetEmailDomains()
+	if err != nil || len(domains.Results) != 1 {
+		t.Fatalf("unexpected domains %+v: %v", domains, err)
+	}
+
+	records, err := client.GetEmailDomainDNSRecords("example.com")
+	if err != nil || len(records) != 2 || records[1].Verified {
+		t.Fatalf("unexpected records %+v: %v", records, err)
+	}
+
+	if err := client.VerifyEmailDomain("example.com"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := client.DeleteEmailDomain("example.com"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := []string{
+		"POST /email/1/domains",
+		"GET /email/1/domains",
+		"GET /email/1/domains/example.com",
+		"POST /email/1/domains/example.com/verify",
+		"DELETE /email/1/domains/example.com",
+	}
+	if fmt.Sprint(calls) != fmt.Sprint(expected) {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
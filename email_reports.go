@@ -0,0 +1,238 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const emailReportsEndpoint = "/email/1/reports"
+const emailLogsEndpoint = "/email/1/logs"
+const emailBulksEndpoint = "/email/1/bulks"
+const emailBulksStatusEndpoint = "/email/1/bulks/status"
+
+// EmailReport is a delivery report of a sent email.
+type EmailReport struct {
+	BulkID       string        `json:"bulkId"`
+	MessageID    string        `json:"messageId"`
+	To           string        `json:"to"`
+	SentAt       Time          `json:"sentAt"`
+	DoneAt       Time          `json:"doneAt"`
+	MessageCount int           `json:"messageCount"`
+	CallbackData string        `json:"callbackData"`
+	Channel      string        `json:"channel"`
+	Price        SentSmsPrice  `json:"price"`
+	Status       SentSmsStatus `json:"status"`
+	Error        SentSmsError  `json:"error"`
+}
+
+// EmailReportResponse contains a collection of reports, one per every email.
+type EmailReportResponse struct {
+	Results []EmailReport `json:"results"`
+}
+
+// EmailLog is a log entry of a sent email.
+type EmailLog struct {
+	BulkID       string        `json:"bulkId"`
+	MessageID    string        `json:"messageId"`
+	To           string        `json:"to"`
+	From         string        `json:"from"`
+	Text         string        `json:"text"`
+	SentAt       Time          `json:"sentAt"`
+	DoneAt       Time          `json:"doneAt"`
+	MessageCount int           `json:"messageCount"`
+	Price        SentSmsPrice  `json:"price"`
+	Status       SentSmsStatus `json:"status"`
+}
+
+// EmailLogsResponse contains a collection of logs, one per every email.
+type EmailLogsResponse struct {
+	Results []EmailLog `json:"results"`
+}
+
+// ScheduledEmailBulks contains scheduled bulks of a request,
+// "ExternalBulkID" is the bulk ID the request was sent with.
+type ScheduledEmailBulks struct {
+	ExternalBulkID string
+	Bulks          []ScheduledBulk
+}
+
+// scheduledEmailBulks is the wire format of ScheduledEmailBulks.
+type scheduledEmailBulks struct {
+	ExternalBulkID string          `json:"externalBulkId"`
+	Bulks          []scheduledBulk `json:"bulks"`
+}
+
+// EmailBulkStatuses contains sending statuses of scheduled bulks of a request.
+type EmailBulkStatuses struct {
+	ExternalBulkID string           `json:"externalBulkId"`
+	Bulks          []BulkStatusInfo `json:"bulks"`
+}
+
+// GetEmailDeliveryReports allows you to get one time delivery reports for sent emails.
+func (c *Client) GetEmailDeliveryReports(query DeliveryReportQuery) (*EmailReportResponse, error) {
+	return c.GetEmailDeliveryReportsContext(context.Background(), query)
+}
+
+// GetEmailDeliveryReportsContext is like GetEmailDeliveryReports but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailDeliveryReportsContext(ctx context.Context, query DeliveryReportQuery) (*EmailReportResponse, error) {
+
+	if query.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	res := EmailReportResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailReportsEndpoint+query.encode(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetEmailLogs allows you to get logs of sent emails matching the query,
+// the filters are the same as of SMS logs except for Mcc and Mnc.
+func (c *Client) GetEmailLogs(query SmsLogsQuery) (*EmailLogsResponse, error) {
+	return c.GetEmailLogsContext(context.Background(), query)
+}
+
+// GetEmailLogsContext is like GetEmailLogs but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailLogsContext(ctx context.Context, query SmsLogsQuery) (*EmailLogsResponse, error) {
+
+	if query.Limit < 0 || query.Limit > maxLogsLimit {
+		return nil, errors.Errorf("limit must be between 0 and %d", maxLogsLimit)
+	}
+
+	res := EmailLogsResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailLogsEndpoint+query.encode(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetScheduledEmailBulks allows you to get the time scheduled email bulks will be sent at.
+func (c *Client) GetScheduledEmailBulks(bulkID string) (*ScheduledEmailBulks, error) {
+	return c.GetScheduledEmailBulksContext(context.Background(), bulkID)
+}
+
+// GetScheduledEmailBulksContext is like GetScheduledEmailBulks but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetScheduledEmailBulksContext(ctx context.Context, bulkID string) (*ScheduledEmailBulks, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	res := scheduledEmailBulks{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailBulksEndpoint+bulkQuery(bulkID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	bulks := &ScheduledEmailBulks{ExternalBulkID: res.ExternalBulkID}
+	for _, b := range res.Bulks {
+		bulks.Bulks = append(bulks.Bulks, *b.parse())
+	}
+
+	return bulks, nil
+}
+
+// RescheduleEmailBulk allows you to change the time a scheduled email bulk will be sent at.
+func (c *Client) RescheduleEmailBulk(bulkID string, sendAt time.Time) (*ScheduledBulk, error) {
+	return c.RescheduleEmailBulkContext(context.Background(), bulkID, sendAt)
+}
+
+// RescheduleEmailBulkContext is like RescheduleEmailBulk but uses the given context
+// for the underlying HTTP request.
+func (c *Client) RescheduleEmailBulkContext(ctx context.Context, bulkID string, sendAt time.Time) (*ScheduledBulk, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	if sendAt.IsZero() {
+		return nil, errors.New("send time must be specified")
+	}
+
+	data, err := json.Marshal(scheduledBulk{SendAt: Time{sendAt}})
+	if err != nil {
+		return nil, err
+	}
+
+	res := scheduledBulk{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+emailBulksEndpoint+bulkQuery(bulkID), bytes.NewBuffer(data), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.parse(), nil
+}
+
+// GetEmailBulkStatus allows you to get sending statuses of scheduled email bulks.
+func (c *Client) GetEmailBulkStatus(bulkID string) (*EmailBulkStatuses, error) {
+	return c.GetEmailBulkStatusContext(context.Background(), bulkID)
+}
+
+// GetEmailBulkStatusContext is like GetEmailBulkStatus but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetEmailBulkStatusContext(ctx context.Context, bulkID string) (*EmailBulkStatuses, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	res := EmailBulkStatuses{}
+	err := c.doRequest(ctx, "GET", c.baseURL+emailBulksStatusEndpoint+bulkQuery(bulkID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateEmailBulkStatus allows you to pause, resume or cancel sending of a scheduled email bulk.
+// Status must be one of BulkPaused, BulkProcessing or BulkCanceled.
+func (c *Client) UpdateEmailBulkStatus(bulkID string, status BulkStatus) (*BulkStatusInfo, error) {
+	return c.UpdateEmailBulkStatusContext(context.Background(), bulkID, status)
+}
+
+// UpdateEmailBulkStatusContext is like UpdateEmailBulkStatus but uses the given context
+// for the underlying HTTP request.
+func (c *Client) UpdateEmailBulkStatusContext(ctx context.Context, bulkID string, status BulkStatus) (*BulkStatusInfo, error) {
+
+	if len(bulkID) < 1 {
+		return nil, errors.New("bulk ID must be specified")
+	}
+
+	if !status.isSettable() {
+		return nil, errors.Errorf("bulk status can't be changed to %q", status)
+	}
+
+	data, err := json.Marshal(BulkStatusInfo{Status: status})
+	if err != nil {
+		return nil, err
+	}
+
+	res := BulkStatusInfo{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+emailBulksStatusEndpoint+bulkQuery(bulkID), bytes.NewBuffer(data), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// NewEmailReportHandler returns an http.Handler receiving delivery reports
+// pushed to the notifyUrl of sent emails. Like with NewDeliveryReportHandler,
+// the same report may be passed to the callback again.
+func NewEmailReportHandler(callback func(context.Context, EmailReport) error, opts ...WebhookOption) http.Handler {
+	return newWebhook(callback, opts...)
+}
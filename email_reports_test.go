@@ -0,0 +1,111 @@
+package infobip_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestEmailReports(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/email/1/reports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("email-report-response.json"))
+	})
+
+	mux.HandleFunc("/email/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("bulkId") != "csdstgteet4fath2pclbq" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("email-report-response.json"))
+	})
+
+	reports, err := client.GetEmailDeliveryReports(infobip.DeliveryReportQuery{BulkID: "csdstgteet4fath2pclbq"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := reports.Results[0]
+	if !report.Status.IsSuccess() || report.Channel != "EMAIL" || report.SentAt.IsZero() {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	logs, err := client.GetEmailLogs(infobip.SmsLogsQuery{BulkID: []string{"csdstgteet4fath2pclbq"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(logs.Results) != 1 || logs.Results[0].To != "john.smith@example.com" {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+
+	var pushed []infobip.EmailReport
+	h := infobip.NewEmailReportHandler(func(ctx context.Context, report infobip.EmailReport) error {
+		pushed = append(pushed, report)
+		return nil
+	})
+
+	if code := push(h, fixture("email-report-response.json"), nil); code != http.StatusOK || len(pushed) != 1 {
+		t.Fatalf("unexpected status %d for %d reports", code, len(pushed))
+	}
+}
+
+func TestScheduledEmailBulks(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/email/1/bulks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "PUT" {
+			fmt.Fprint(w, `{"bulkId": "bulk-1", "sendAt": "2020-05-15T12:00:00.000+0000"}`)
+			return
+		}
+		fmt.Fprint(w, `{"externalBulkId": "my-bulk", "bulks": [{"bulkId": "bulk-1", "sendAt": 1589544000000}]}`)
+	})
+
+	mux.HandleFunc("/email/1/bulks/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "PUT" {
+			fmt.Fprint(w, `{"bulkId": "bulk-1", "status": "CANCELED"}`)
+			return
+		}
+		fmt.Fprint(w, `{"externalBulkId": "my-bulk", "bulks": [{"bulkId": "bulk-1", "status": "PAUSED"}]}`)
+	})
+
+	expected := time.Date(2020, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	bulks, err := client.GetScheduledEmailBulks("my-bulk")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if bulks.ExternalBulkID != "my-bulk" || len(bulks.Bulks) != 1 || !bulks.Bulks[0].SendAt.Equal(expected) {
+		t.Fatalf("unexpected bulks: %+v", bulks)
+	}
+
+	bulk, err := client.RescheduleEmailBulk("bulk-1", expected)
+	if err != nil || !bulk.SendAt.Equal(expected) {
+		t.Fatalf("unexpected bulk %+v: %v", bulk, err)
+	}
+
+	if _, err := client.RescheduleEmailBulk("bulk-1", time.Time{}); err == nil {
+		t.Fatal("Should fail without send time")
+	}
+
+	statuses, err := client.GetEmailBulkStatus("my-bulk")
+	if err != nil || statuses.Bulks[0].Status != infobip.BulkPaused {
+		t.Fatalf("unexpected statuses %+v: %v", statuses, err)
+	}
+
+	status, err := client.UpdateEmailBulkStatus("bulk-1", infobip.BulkCanceled)
+	if err != nil || status.Status != infobip.BulkCanceled {
+		t.Fatalf("unexpected status %+v: %v", status, err)
+	}
+}
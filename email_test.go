@@ -0,0 +1,76 @@
+package infobip_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestSendEmail(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var form *http.Request
+	var attachment string
+	mux.HandleFunc("/email/2/send", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("couldn't parse form: %v", err)
+		}
+		form = r
+
+		f, _, err := r.FormFile("attachment")
+		if err == nil {
+			b, _ := ioutil.ReadAll(f)
+			attachment = string(b)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"bulkId": "esy82u83yne3zh2p", "messages": [{"to": "john.smith@example.com", "messageCount": 1, "messageId": "m1", "status": {"groupId": 1, "groupName": "PENDING", "id": 7, "name": "PENDING_ENROUTE", "description": "Message sent to next instance"}}]}`)
+	})
+
+	trackClicks := false
+	email := infobip.Email{
+		From:        "Jane Smith <jane.smith@example.com>",
+		To:          []string{"john.smith@example.com", "alice@example.com"},
+		Cc:          []string{"bob@example.com"},
+		Subject:     "Mail subject text",
+		HTML:        "<h1>Html body</h1><img src=\"cid:logo.png\">",
+		TrackClicks: &trackClicks,
+		NotifyURL:   "https://example.com/email-reports",
+		Attachments: []infobip.Attachment{
+			{Name: "report.csv", Reader: strings.NewReader("a,b\n1,2\n")},
+		},
+		InlineImages: []infobip.Attachment{
+			{Name: "logo.png", Reader: strings.NewReader("png")},
+		},
+	}
+
+	res, err := client.SendEmail(&email)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.BulkID != "esy82u83yne3zh2p" || res.Messages[0].Status.GroupName != infobip.StatusPending {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	if !strings.HasPrefix(form.Header.Get("Content-Type"), "multipart/form-data") {
+		t.Fatalf("unexpected content type: %s", form.Header.Get("Content-Type"))
+	}
+
+	if len(form.MultipartForm.Value["to"]) != 2 || form.FormValue("trackClicks") != "false" || form.FormValue("track") != "" {
+		t.Fatalf("unexpected form: %+v", form.MultipartForm.Value)
+	}
+
+	if attachment != "a,b\n1,2\n" || len(form.MultipartForm.File["inlineImage"]) != 1 {
+		t.Fatalf("unexpected files: %+v", form.MultipartForm.File)
+	}
+
+	if _, err := client.SendEmail(&infobip.Email{From: "jane.smith@example.com"}); !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,146 @@
+package infobip
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// APIError is returned when the API answers with a non-successful HTTP status.
+// MessageID and Text come from the requestError.serviceException object of the
+// response body, Body holds the raw response body as it was received.
+// RetryAfter is set when the response has a Retry-After header.
+type APIError struct {
+	StatusCode       int
+	Status           string
+	MessageID        string
+	Text             string
+	ValidationErrors map[string][]string
+	Body             []byte
+	RetryAfter       time.Duration
+}
+
+// Error is an implementation of error interface for the APIError type.
+func (e *APIError) Error() string {
+	if len(e.MessageID) < 1 && len(e.Text) < 1 {
+		return e.Status
+	}
+	return fmt.Sprintf("%s: %s: %s", e.Status, e.MessageID, e.Text)
+}
+
+// apiErrorBody is the error payload returned by the API.
+type apiErrorBody struct {
+	RequestError struct {
+		ServiceException struct {
+			MessageID        string              `json:"messageId"`
+			Text             string              `json:"text"`
+			ValidationErrors map[string][]string `json:"validationErrors"`
+		} `json:"serviceException"`
+	} `json:"requestError"`
+}
+
+// newAPIError builds an APIError from the given response, the body
+// is parsed on a best effort basis.
+func newAPIError(resp *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return apiErr
+	}
+	apiErr.Body = body
+
+	parsed := apiErrorBody{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return apiErr
+	}
+
+	exception := parsed.RequestError.ServiceException
+	apiErr.MessageID = exception.MessageID
+	apiErr.Text = exception.Text
+	apiErr.ValidationErrors = exception.ValidationErrors
+
+	return apiErr
+}
+
+// parseRetryAfter parses Retry-After header given either in seconds or as HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if len(value) < 1 {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// IsUnauthorized reports whether err is an APIError caused by
+// missing or invalid credentials.
+func IsUnauthorized(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.MessageID == "UNAUTHORIZED"
+}
+
+// IsRateLimited reports whether err is an APIError caused by
+// exceeding the allowed request rate, or the client-side rate limit was hit.
+func IsRateLimited(err error) bool {
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.MessageID == "TOO_MANY_REQUESTS"
+}
+
+// IsValidationError reports whether err is an APIError caused by
+// an invalid request payload, or the payload has failed client-side validation.
+func IsValidationError(err error) bool {
+	var validationErrs ValidationErrors
+	if errors.As(err, &validationErrs) {
+		return true
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusBadRequest || len(apiErr.ValidationErrors) > 0
+}
+
+// hasErrorCode reports whether err is an APIError with the given service exception ID
+// or a PINError with the given PIN error.
+func hasErrorCode(err error, code string) bool {
+	var pinErr *PINError
+	if errors.As(err, &pinErr) {
+		return pinErr.PinError == code
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.MessageID == code
+}
@@ -0,0 +1,75 @@
+package infobip_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+// failingAPI starts a fake API answering every request with the given
+// status and fixture.
+func failingAPI(t *testing.T, status int, path string) (*infobip.Client, func()) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, fixture(path))
+	}))
+
+	c, err := infobip.New(infobip.BaseURL(s.URL))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c, s.Close
+}
+
+func TestAPIErrorUnauthorized(t *testing.T) {
+	c, tearDown := failingAPI(t, http.StatusUnauthorized, "unauthorized-response.json")
+	defer tearDown()
+
+	_, err := c.GetDeliveryReport("1")
+	if err == nil {
+		t.Fatal("Should fail on unauthorized response")
+	}
+
+	var apiErr *infobip.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("unexpected status code: %d", apiErr.StatusCode)
+	}
+
+	if apiErr.MessageID != "UNAUTHORIZED" || apiErr.Text != "Invalid login details" {
+		t.Fatalf("service exception parsing failed: %+v", apiErr)
+	}
+
+	if len(apiErr.Body) < 1 {
+		t.Fatal("raw body is missing")
+	}
+
+	if !infobip.IsUnauthorized(err) || infobip.IsRateLimited(err) || infobip.IsValidationError(err) {
+		t.Fatalf("wrong error classification: %+v", apiErr)
+	}
+}
+
+func TestAPIErrorValidation(t *testing.T) {
+	c, tearDown := failingAPI(t, http.StatusBadRequest, "validation-error-response.json")
+	defer tearDown()
+
+	_, err := c.SendSMS(&infobip.SMS{From: "sender", To: []string{"+12125551234"}, Text: "some message"})
+	if !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	var apiErr *infobip.APIError
+	errors.As(err, &apiErr)
+	if len(apiErr.ValidationErrors["to"]) != 1 {
+		t.Fatalf("validation errors parsing failed: %+v", apiErr)
+	}
+}
@@ -0,0 +1,8 @@
+package infobip
+
+import "time"
+
+// Backoff exposes the retry delay to the external tests.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	return p.backoff(attempt, nil)
+}
@@ -0,0 +1,131 @@
+package infobip
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const inboxEndpoint = "/sms/1/inbox/reports"
+
+// InboundMessage is a message received from a mobile subscriber.
+// "CleanText" is the text without the keyword, "Keyword" is set when
+// the text starts with a keyword registered for the number.
+type InboundMessage struct {
+	MessageID    string       `json:"messageId"`
+	From         string       `json:"from"`
+	To           string       `json:"to"`
+	Text         string       `json:"text"`
+	CleanText    string       `json:"cleanText"`
+	Keyword      string       `json:"keyword"`
+	ReceivedAt   Time         `json:"receivedAt"`
+	SmsCount     int          `json:"smsCount"`
+	Price        SentSmsPrice `json:"price"`
+	CallbackData string       `json:"callbackData"`
+}
+
+// InboundMessagesResponse contains a collection of received messages.
+// "PendingMessageCount" is the number of messages left to be fetched.
+type InboundMessagesResponse struct {
+	Results             []InboundMessage `json:"results"`
+	MessageCount        int              `json:"messageCount"`
+	PendingMessageCount int              `json:"pendingMessageCount"`
+}
+
+// GetInboundMessages allows you to get received messages not fetched yet,
+// up to limit messages at once. Zero limit uses the API default.
+func (c *Client) GetInboundMessages(limit int) (*InboundMessagesResponse, error) {
+	return c.GetInboundMessagesContext(context.Background(), limit)
+}
+
+// GetInboundMessagesContext is like GetInboundMessages but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetInboundMessagesContext(ctx context.Context, limit int) (*InboundMessagesResponse, error) {
+
+	if limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	query := ""
+	if limit > 0 {
+		query = "?" + url.Values{"limit": {strconv.Itoa(limit)}}.Encode()
+	}
+
+	res := InboundMessagesResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+inboxEndpoint+query, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// NewInboundMessageHandler returns an http.Handler receiving messages forwarded
+// by the API. A message may be forwarded again when the callback fails for it
+// or for any other message of the same push.
+func NewInboundMessageHandler(callback func(context.Context, InboundMessage) error, opts ...WebhookOption) http.Handler {
+	return newWebhook(callback, opts...)
+}
+
+// KeywordRouter dispatches inbound messages to handlers registered per keyword.
+// Keywords are case insensitive. Messages without a registered keyword
+// go to the default handler, or are ignored when there is none.
+//
+//	router := infobip.NewKeywordRouter()
+//	router.Handle("STOP", unsubscribe)
+//	http.Handle("/inbox", infobip.NewInboundMessageHandler(router.Route))
+type KeywordRouter struct {
+	mu       sync.RWMutex
+	handlers map[string]func(context.Context, InboundMessage) error
+	fallback func(context.Context, InboundMessage) error
+}
+
+// NewKeywordRouter is the constructor for the KeywordRouter.
+func NewKeywordRouter() *KeywordRouter {
+	return &KeywordRouter{
+		handlers: make(map[string]func(context.Context, InboundMessage) error),
+	}
+}
+
+// Handle registers the handler for messages with the given keyword.
+func (k *KeywordRouter) Handle(keyword string, handler func(context.Context, InboundMessage) error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.handlers[strings.ToUpper(keyword)] = handler
+}
+
+// HandleDefault registers the handler for messages without a registered keyword.
+func (k *KeywordRouter) HandleDefault(handler func(context.Context, InboundMessage) error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.fallback = handler
+}
+
+// Route passes the message to the handler of its keyword. When the API hasn't
+// recognized any keyword, the first word of the text is used instead.
+func (k *KeywordRouter) Route(ctx context.Context, message InboundMessage) error {
+	keyword := message.Keyword
+	if len(keyword) < 1 {
+		if fields := strings.Fields(message.Text); len(fields) > 0 {
+			keyword = fields[0]
+		}
+	}
+
+	k.mu.RLock()
+	handler, ok := k.handlers[strings.ToUpper(keyword)]
+	if !ok {
+		handler = k.fallback
+	}
+	k.mu.RUnlock()
+
+	if handler == nil {
+		return nil
+	}
+
+	return handler(ctx, message)
+}
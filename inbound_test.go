@@ -0,0 +1,64 @@
+package infobip_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestGetInboundMessages(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/sms/1/inbox/reports", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("limit") != "2" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("inbound-messages-response.json"))
+	})
+
+	res, err := client.GetInboundMessages(2)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.MessageCount != 2 || len(res.Results) != 2 {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	message := res.Results[0]
+	if message.Keyword != "QUIZ" || message.CleanText != "Correct answer is Paris" || message.ReceivedAt.IsZero() {
+		t.Fatalf("unexpected message: %+v", message)
+	}
+}
+
+func TestInboundMessageHandlerWithRouter(t *testing.T) {
+	var quiz, stop, other int
+
+	router := infobip.NewKeywordRouter()
+	router.Handle("quiz", func(ctx context.Context, message infobip.InboundMessage) error {
+		quiz++
+		return nil
+	})
+	router.Handle("STOP", func(ctx context.Context, message infobip.InboundMessage) error {
+		stop++
+		return nil
+	})
+	router.HandleDefault(func(ctx context.Context, message infobip.InboundMessage) error {
+		other++
+		return nil
+	})
+
+	h := infobip.NewInboundMessageHandler(router.Route)
+	if code := push(h, fixture("inbound-messages-response.json"), nil); code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", code)
+	}
+
+	if quiz != 1 || stop != 1 || other != 0 {
+		t.Fatalf("unexpected routing: quiz=%d stop=%d other=%d", quiz, stop, other)
+	}
+}
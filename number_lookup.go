@@ -0,0 +1,127 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const numberQueryEndpoint = "/number/1/query"
+const numberNotifyEndpoint = "/number/1/notify"
+
+// Network describes a mobile network.
+type Network struct {
+	NetworkName   string `json:"networkName"`
+	NetworkPrefix string `json:"networkPrefix"`
+	CountryName   string `json:"countryName"`
+	CountryPrefix string `json:"countryPrefix"`
+	NetworkID     int    `json:"networkId"`
+}
+
+// NumberLookupResult is the result of a number lookup (HLR query).
+// "OriginalNetwork" is the network the number was assigned to, "PortedNetwork"
+// is set for ported numbers and "RoamingNetwork" for roaming subscribers.
+type NumberLookupResult struct {
+	To              string        `json:"to"`
+	MccMnc          string        `json:"mccMnc"`
+	Imsi            string        `json:"imsi"`
+	OriginalNetwork Network       `json:"originalNetwork"`
+	Ported          bool          `json:"ported"`
+	PortedNetwork   *Network      `json:"portedNetwork"`
+	Roaming         bool          `json:"roaming"`
+	RoamingNetwork  *Network      `json:"roamingNetwork"`
+	Status          SentSmsStatus `json:"status"`
+	Error           SentSmsError  `json:"error"`
+	Price           SentSmsPrice  `json:"price"`
+}
+
+// NumberLookupResponse contains lookup results, one per every number.
+type NumberLookupResponse struct {
+	BulkID  string               `json:"bulkId"`
+	Results []NumberLookupResult `json:"results"`
+}
+
+// NumberLookupAsyncResponse contains the IDs of accepted lookups,
+// the results are pushed to the notify URL.
+type NumberLookupAsyncResponse struct {
+	BulkID  string               `json:"bulkId"`
+	Results []SmsResponseDetails `json:"results"`
+}
+
+// numberLookupRequest is the payload of the number lookup requests.
+type numberLookupRequest struct {
+	To                []string `json:"to"`
+	NotifyURL         string   `json:"notifyUrl,omitempty"`
+	NotifyContentType string   `json:"notifyContentType,omitempty"`
+}
+
+func (r *numberLookupRequest) buffer() *bytes.Buffer {
+	b, _ := json.Marshal(r)
+	return bytes.NewBuffer(b)
+}
+
+// LookupNumber allows you to check numbers for validity, porting and roaming.
+func (c *Client) LookupNumber(numbers []string) (*NumberLookupResponse, error) {
+	return c.LookupNumberContext(context.Background(), numbers)
+}
+
+// LookupNumberContext is like LookupNumber but uses the given context
+// for the underlying HTTP request.
+func (c *Client) LookupNumberContext(ctx context.Context, numbers []string) (*NumberLookupResponse, error) {
+
+	if len(numbers) < 1 {
+		return nil, errors.New("at least one number must be specified")
+	}
+
+	payload := numberLookupRequest{To: numbers}
+
+	res := NumberLookupResponse{}
+	err := c.doRequest(ctx, "POST", c.baseURL+numberQueryEndpoint, payload.buffer(), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// LookupNumbersAsync allows you to look up many numbers at once, the results
+// are pushed to notifyURL and can be received with NewNumberLookupHandler.
+func (c *Client) LookupNumbersAsync(numbers []string, notifyURL string) (*NumberLookupAsyncResponse, error) {
+	return c.LookupNumbersAsyncContext(context.Background(), numbers, notifyURL)
+}
+
+// LookupNumbersAsyncContext is like LookupNumbersAsync but uses the given context
+// for the underlying HTTP request.
+func (c *Client) LookupNumbersAsyncContext(ctx context.Context, numbers []string, notifyURL string) (*NumberLookupAsyncResponse, error) {
+
+	if len(numbers) < 1 {
+		return nil, errors.New("at least one number must be specified")
+	}
+
+	if len(notifyURL) < 1 {
+		return nil, errors.New("notify URL must be specified")
+	}
+
+	payload := numberLookupRequest{
+		To:                numbers,
+		NotifyURL:         notifyURL,
+		NotifyContentType: "application/json",
+	}
+
+	res := NumberLookupAsyncResponse{}
+	err := c.doRequest(ctx, "POST", c.baseURL+numberNotifyEndpoint, payload.buffer(), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// NewNumberLookupHandler returns an http.Handler receiving results of asynchronous
+// number lookups. Results may arrive more than once, see WebhookOption.
+func NewNumberLookupHandler(callback func(context.Context, NumberLookupResult) error, opts ...WebhookOption) http.Handler {
+	return newWebhook(callback, opts...)
+}
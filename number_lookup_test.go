@@ -0,0 +1,74 @@
+package infobip_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestLookupNumber(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/number/1/query", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("number-lookup-response.json"))
+	})
+
+	res, err := client.LookupNumber([]string{"41793026727"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res.Results) != 1 {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	result := res.Results[0]
+	if !result.Ported || result.PortedNetwork.NetworkName != "Sunrise" || result.RoamingNetwork != nil {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if result.Price.PricePerMessage.String() != "0.01" {
+		t.Fatalf("Price parsing failed")
+	}
+}
+
+func TestLookupNumbersAsync(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var payload map[string]interface{}
+	mux.HandleFunc("/number/1/notify", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"bulkId": "bulk", "results": [{"to": "41793026727", "messageId": "1"}]}`)
+	})
+
+	res, err := client.LookupNumbersAsync([]string{"41793026727"}, "https://example.com/hlr")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if res.BulkID != "bulk" || payload["notifyUrl"] != "https://example.com/hlr" {
+		t.Fatalf("unexpected response %+v for payload %+v", res, payload)
+	}
+
+	var results []infobip.NumberLookupResult
+	h := infobip.NewNumberLookupHandler(func(ctx context.Context, result infobip.NumberLookupResult) error {
+		results = append(results, result)
+		return nil
+	})
+
+	if code := push(h, fixture("number-lookup-response.json"), nil); code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", code)
+	}
+
+	if len(results) != 1 || results[0].Imsi != "228017000000000" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
@@ -0,0 +1,87 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const previewEndpoint = "/sms/1/preview"
+
+// PreviewOptions sets the language and transliteration to preview the text with.
+// Empty options preview the text as is.
+type PreviewOptions struct {
+	LanguageCode    string `json:"languageCode,omitempty"`
+	Transliteration string `json:"transliteration,omitempty"`
+}
+
+// previewRequest is the payload of the preview request.
+type previewRequest struct {
+	Text string `json:"text"`
+	PreviewOptions
+}
+
+// PreviewLanguage is the language configuration of a preview.
+// Single and locking shift tables extend the GSM-7 alphabet with national characters.
+type PreviewLanguage struct {
+	LanguageCode string `json:"languageCode"`
+	SingleShift  bool   `json:"singleShift"`
+	LockingShift bool   `json:"lockingShift"`
+}
+
+// PreviewConfiguration is the configuration a preview was made with.
+type PreviewConfiguration struct {
+	Language        *PreviewLanguage `json:"language"`
+	Transliteration string           `json:"transliteration"`
+}
+
+// Preview shows how the text will look like and how many messages it takes
+// with a specific configuration.
+type Preview struct {
+	TextPreview         string               `json:"textPreview"`
+	MessageCount        int                  `json:"messageCount"`
+	CharactersRemaining int                  `json:"charactersRemaining"`
+	Configuration       PreviewConfiguration `json:"configuration"`
+}
+
+// PreviewResponse contains the original text and its previews,
+// one per every applicable configuration.
+type PreviewResponse struct {
+	OriginalText string    `json:"originalText"`
+	Previews     []Preview `json:"previews"`
+}
+
+// PreviewSMS allows you to check how the text will be split and transliterated
+// before sending it.
+func (c *Client) PreviewSMS(text string, opts PreviewOptions) (*PreviewResponse, error) {
+	return c.PreviewSMSContext(context.Background(), text, opts)
+}
+
+// PreviewSMSContext is like PreviewSMS but uses the given context
+// for the underlying HTTP request.
+func (c *Client) PreviewSMSContext(ctx context.Context, text string, opts PreviewOptions) (*PreviewResponse, error) {
+
+	if len(text) < 1 {
+		return nil, errors.New("text must be specified")
+	}
+
+	data, err := json.Marshal(previewRequest{Text: text, PreviewOptions: opts})
+	if err != nil {
+		return nil, err
+	}
+
+	// preview doesn't change anything, so it's safe to retry
+	res := PreviewResponse{}
+	err = c.doRetryableRequest(ctx, "POST", c.baseURL+previewEndpoint, bytes.NewBuffer(data), &res, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Previews) < 1 {
+		return nil, errors.Errorf("Couldn't preview a message: %+v", res)
+	}
+
+	return &res, nil
+}
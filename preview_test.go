@@ -0,0 +1,41 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestPreviewSMS(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var payload map[string]string
+	mux.HandleFunc("/sms/1/preview", func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("preview-response.json"))
+	})
+
+	text := "Let's see how many characters will remain unused in this message."
+	res, err := client.PreviewSMS(text, infobip.PreviewOptions{LanguageCode: "TR", Transliteration: "TURKISH"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if payload["text"] != text || payload["languageCode"] != "TR" || payload["transliteration"] != "TURKISH" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+
+	if len(res.Previews) != 2 || res.Previews[0].Configuration.Language != nil {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	preview := res.Previews[1]
+	if preview.MessageCount != 1 || preview.CharactersRemaining != 94 || !preview.Configuration.Language.SingleShift {
+		t.Fatalf("unexpected preview: %+v", preview)
+	}
+}
@@ -0,0 +1,176 @@
+package infobip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointFamily groups API endpoints sharing a rate limit.
+type EndpointFamily string
+
+// Endpoint families which can be rate limited.
+const (
+	FamilySend    EndpointFamily = "send"
+	FamilyReports EndpointFamily = "reports"
+	FamilyLogs    EndpointFamily = "logs"
+)
+
+// familyPatterns maps endpoint paths to families, patterns use the syntax
+// of path.Match and the first matching pattern wins.
+var familyPatterns = []struct {
+	pattern string
+	family  EndpointFamily
+}{
+	{"/*/*/reports", FamilyReports},
+	{"/sms/*/inbox/reports", FamilyReports},
+	{"/*/*/logs", FamilyLogs},
+	{"/sms/*/text/*", FamilySend},
+	{"/sms/*/binary/*", FamilySend},
+	{"/tts/*/single", FamilySend},
+	{"/tts/*/multi", FamilySend},
+	{"/tts/*/advanced", FamilySend},
+	{"/email/*/send", FamilySend},
+	// PINs are sent over SMS or voice, unlike their verification
+	{"/2fa/*/pin", FamilySend},
+	{"/2fa/*/pin/voice", FamilySend},
+	{"/2fa/*/pin/*/resend", FamilySend},
+	{"/2fa/*/pin/*/resend/voice", FamilySend},
+}
+
+// endpointFamily returns the family of the endpoint path, an empty family is never limited.
+func endpointFamily(endpoint string) EndpointFamily {
+	for _, p := range familyPatterns {
+		if ok, _ := path.Match(p.pattern, endpoint); ok {
+			return p.family
+		}
+	}
+	return ""
+}
+
+// endpoint returns the API endpoint path of the request, without the base URL path.
+func (c *Client) endpoint(req *http.Request) string {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return req.URL.Path
+	}
+	return strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(base.Path, "/"))
+}
+
+// ErrRateLimited is matched by errors returned when the client-side
+// rate limit is exceeded in non-blocking mode.
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimitError is returned instead of sending a request
+// which would exceed the client-side rate limit.
+type RateLimitError struct {
+	Family EndpointFamily
+	// Wait is the time until the next request is allowed.
+	Wait time.Duration
+}
+
+// Error is an implementation of error interface for the RateLimitError type.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s: %s endpoints, retry in %s", ErrRateLimited, e.Family, e.Wait)
+}
+
+// Is allows matching RateLimitError against ErrRateLimited with errors.Is.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// WithRateLimit limits the rate of requests to the endpoint family,
+// perSecond is the sustained rate and burst is the number of requests allowed at once
+func WithRateLimit(family EndpointFamily, perSecond float64, burst int) Option {
+	return func(c *Client) error {
+		if len(family) < 1 {
+			return errors.New("endpoint family must be specified")
+		}
+		if perSecond <= 0 || burst < 1 {
+			return errors.New("rate and burst must be positive")
+		}
+		if c.limiters == nil {
+			c.limiters = make(map[EndpointFamily]*tokenBucket)
+		}
+		c.limiters[family] = newTokenBucket(perSecond, burst)
+		return nil
+	}
+}
+
+// WithNonBlockingRateLimit makes rate limited calls fail with RateLimitError
+// instead of waiting for the limit to allow them
+func WithNonBlockingRateLimit() Option {
+	return func(c *Client) error {
+		c.rateLimitNonBlocking = true
+		return nil
+	}
+}
+
+// waitRateLimit blocks until the rate limit of the request endpoint allows it,
+// in non-blocking mode it fails right away.
+func (c *Client) waitRateLimit(ctx context.Context, req *http.Request) error {
+	family := endpointFamily(c.endpoint(req))
+	bucket, ok := c.limiters[family]
+	if !ok {
+		return nil
+	}
+
+	for {
+		wait := bucket.take(time.Now())
+		if wait == 0 {
+			return nil
+		}
+
+		if c.rateLimitNonBlocking {
+			return &RateLimitError{Family: family, Wait: wait}
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// tokenBucket is a goroutine safe token bucket rate limiter.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(perSecond float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   perSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// take consumes a token and returns zero, or returns
+// how long to wait for the next token when the bucket is empty.
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,131 @@
+package infobip_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestNonBlockingRateLimit(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	c, err := infobip.New(
+		infobip.BaseURL(server.URL),
+		infobip.WithRateLimit(infobip.FamilyReports, 0.1, 1),
+		infobip.WithNonBlockingRateLimit(),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := c.GetDeliveryReport("1"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = c.GetDeliveryReport("1")
+	if !errors.Is(err, infobip.ErrRateLimited) || !infobip.IsRateLimited(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	var limitErr *infobip.RateLimitError
+	if !errors.As(err, &limitErr) || limitErr.Family != infobip.FamilyReports {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// other families are not limited
+	sms := infobip.SMS{From: "sender", To: []string{"+12125551234"}, Text: "some message"}
+	if _, err := c.SendSMS(&sms); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestBlockingRateLimit(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	c, err := infobip.New(
+		infobip.BaseURL(server.URL),
+		infobip.WithRateLimit(infobip.FamilyReports, 20, 1),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := c.GetDeliveryReport("1"); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("requests were not throttled: %s", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = c.GetDeliveryReportContext(ctx, "1")
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+// limitedClient starts a fake API answering every request as a sent message,
+// behind the given base URL path and with a non-blocking limit of one request.
+func limitedClient(t *testing.T, basePath string, family infobip.EndpointFamily) *infobip.Client {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("sms-sent-response.json"))
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := infobip.New(
+		infobip.BaseURL(s.URL+basePath),
+		infobip.WithRateLimit(family, 0.1, 1),
+		infobip.WithNonBlockingRateLimit(),
+	)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return c
+}
+
+func TestRateLimitIgnoresBaseURL(t *testing.T) {
+	c := limitedClient(t, "/reports", infobip.FamilyReports)
+
+	sms := infobip.SMS{From: "sender", To: []string{"+12125551234"}, Text: "some message"}
+	for i := 0; i < 3; i++ {
+		if _, err := c.SendSMS(&sms); err != nil {
+			t.Fatalf("sending must not be limited as reports: %v", err)
+		}
+	}
+}
+
+func TestRateLimitPIN(t *testing.T) {
+	c := limitedClient(t, "", infobip.FamilySend)
+
+	pin := infobip.PINRequest{ApplicationID: "app", MessageID: "template", To: "41793026727"}
+	if _, err := c.SendPIN(&pin); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := c.SendPIN(&pin); !errors.Is(err, infobip.ErrRateLimited) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	if _, err := c.ResendPIN("pin", nil); !errors.Is(err, infobip.ErrRateLimited) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	if _, err := c.VerifyPIN("pin", "1234"); err != nil {
+		t.Fatalf("verification must not be limited: %v", err)
+	}
+}
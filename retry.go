@@ -0,0 +1,151 @@
+package infobip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy describes how failed requests are repeated.
+// Only idempotent requests are retried: GET, PUT and DELETE calls
+// and sends with a caller-provided message ID.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// MinBackoff is the delay before the first retry, doubled on every next one.
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0 to 1) of the delay that is randomized.
+	Jitter float64
+	// RetryableStatuses lists HTTP statuses worth another attempt,
+	// transient connection failures like timeouts and resets are always retried.
+	RetryableStatuses []int
+	// RespectRetryAfter makes the client wait as long as the Retry-After header says.
+	// When the header asks for more than MaxBackoff, the request is not retried.
+	RespectRetryAfter bool
+}
+
+// DefaultRetryPolicy is a reasonable policy for most applications.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+	Jitter:      0.2,
+	RetryableStatuses: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+	RespectRetryAfter: true,
+}
+
+// WithRetryPolicy enables retries of failed requests, by default every request is sent once
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) error {
+		if policy.MaxAttempts < 1 {
+			return errors.New("max attempts must be positive")
+		}
+		if policy.MinBackoff < 0 || policy.MaxBackoff < policy.MinBackoff {
+			return errors.New("invalid backoff range")
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return errors.New("jitter must be between 0 and 1")
+		}
+		c.retryPolicy = policy
+		return nil
+	}
+}
+
+// shouldRetry reports whether another attempt may follow the given one.
+func (p *RetryPolicy) shouldRetry(attempt int, err error) bool {
+	if err == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if p.RespectRetryAfter && apiErr.RetryAfter > p.MaxBackoff {
+			return false
+		}
+		for _, status := range p.RetryableStatuses {
+			if apiErr.StatusCode == status {
+				return true
+			}
+		}
+		return false
+	}
+
+	// connection failures come from http.Client as *url.Error,
+	// while cancellation is reported with a plain context error
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && isTransient(urlErr.Err)
+}
+
+// isTransient reports whether a connection failure may go away on another attempt,
+// unlike an invalid URL or a failed TLS verification.
+func isTransient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+
+	return errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, syscall.EPIPE)
+}
+
+// backoff returns the delay before the attempt following the given one.
+func (p *RetryPolicy) backoff(attempt int, err error) time.Duration {
+	var apiErr *APIError
+	if p.RespectRetryAfter && errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter
+	}
+
+	delay := float64(p.MinBackoff) * math.Pow(2, float64(attempt-1))
+	if delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	delay -= delay * p.Jitter * rand.Float64()
+
+	return time.Duration(delay)
+}
+
+// isIdempotent reports whether requests with the given method may be safely repeated.
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,247 @@
+package infobip_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+// flakyAPI starts a fake API failing the first failures calls to every endpoint.
+func flakyAPI(t *testing.T, failures int32) (*infobip.Client, *int32, func()) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method == "POST" {
+			fmt.Fprint(w, fixture("sms-sent-response.json"))
+			return
+		}
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	}))
+
+	policy := infobip.DefaultRetryPolicy
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	c, err := infobip.New(infobip.BaseURL(s.URL), infobip.WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c, &calls, s.Close
+}
+
+func TestRetryIdempotentRequest(t *testing.T) {
+	c, calls, tearDown := flakyAPI(t, 2)
+	defer tearDown()
+
+	if _, err := c.GetDeliveryReport("1"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	c, calls, tearDown := flakyAPI(t, 5)
+	defer tearDown()
+
+	if _, err := c.GetDeliveryReport("1"); err == nil {
+		t.Fatal("Should fail after the last attempt")
+	}
+
+	if *calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", *calls)
+	}
+}
+
+func TestRetrySendSMS(t *testing.T) {
+	c, calls, tearDown := flakyAPI(t, 1)
+	defer tearDown()
+
+	sms := infobip.SMS{
+		From: "sender",
+		To:   []string{"+12125551234"},
+		Text: "some message",
+	}
+
+	if _, err := c.SendSMS(&sms); err == nil {
+		t.Fatal("SMS without message ID must not be retried")
+	}
+
+	sms.MessageID = "my-message-id"
+	if _, err := c.SendSMS(&sms); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+// retryAfterAPI starts a fake API rate limiting the first call with the given Retry-After header.
+func retryAfterAPI(t *testing.T, retryAfter string, maxBackoff time.Duration) (*infobip.Client, *int32) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	}))
+	t.Cleanup(s.Close)
+
+	policy := infobip.DefaultRetryPolicy
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = maxBackoff
+
+	c, err := infobip.New(infobip.BaseURL(s.URL), infobip.WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c, &calls
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	c, calls := retryAfterAPI(t, "1", 2*time.Second)
+
+	start := time.Now()
+	if _, err := c.GetDeliveryReport("1"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Retry-After must be respected, retried after %v", elapsed)
+	}
+
+	if *calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestRetryAfterDate(t *testing.T) {
+	at := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	c, calls := retryAfterAPI(t, at, time.Second)
+
+	_, err := c.GetDeliveryReport("1")
+
+	var apiErr *infobip.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %v", err)
+	}
+
+	if apiErr.RetryAfter < 59*time.Minute || apiErr.RetryAfter > time.Hour {
+		t.Fatalf("unexpected Retry-After: %v", apiErr.RetryAfter)
+	}
+
+	// waiting longer than MaxBackoff is not worth it
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestRetryAfterTooLong(t *testing.T) {
+	c, calls := retryAfterAPI(t, "3600", time.Second)
+
+	start := time.Now()
+	_, err := c.GetDeliveryReport("1")
+	if !infobip.IsRateLimited(err) {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("must give up instead of waiting, took %v", elapsed)
+	}
+
+	if *calls != 1 {
+		t.Fatalf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestRetryJitter(t *testing.T) {
+	policy := infobip.DefaultRetryPolicy
+	policy.MinBackoff = 100 * time.Millisecond
+	policy.MaxBackoff = 300 * time.Millisecond
+	policy.Jitter = 0
+
+	for attempt, expected := range []time.Duration{100, 200, 300, 300} {
+		if d := policy.Backoff(attempt + 1); d != expected*time.Millisecond {
+			t.Fatalf("expected %v before attempt %d, got %v", expected*time.Millisecond, attempt+2, d)
+		}
+	}
+
+	policy.Jitter = 0.5
+	delays := map[time.Duration]bool{}
+	for i := 0; i < 50; i++ {
+		d := policy.Backoff(2)
+		if d < 100*time.Millisecond || d > 200*time.Millisecond {
+			t.Fatalf("delay %v out of jitter range", d)
+		}
+		delays[d] = true
+	}
+
+	if len(delays) < 2 {
+		t.Fatal("delays must be randomized")
+	}
+}
+
+// failingTransport fails every request with the given error.
+type failingTransport struct {
+	err   error
+	calls int
+}
+
+func (t *failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	t.calls++
+	return nil, t.err
+}
+
+func TestRetryConnectionErrors(t *testing.T) {
+	policy := infobip.DefaultRetryPolicy
+	policy.MinBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	tests := []struct {
+		err   error
+		calls int
+	}{
+		{&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, 3},
+		{io.ErrUnexpectedEOF, 3},
+		{x509.UnknownAuthorityError{}, 1},
+		{errors.New("unsupported protocol scheme"), 1},
+	}
+
+	for _, test := range tests {
+		transport := &failingTransport{err: test.err}
+		c, err := infobip.New(infobip.WithTransport(transport), infobip.WithRetryPolicy(policy))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if _, err := c.GetDeliveryReport("1"); err == nil {
+			t.Fatal("Should fail on connection error")
+		}
+
+		if transport.calls != test.calls {
+			t.Fatalf("expected %d calls on %v, got %d", test.calls, test.err, transport.calls)
+		}
+	}
+}
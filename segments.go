@@ -0,0 +1,117 @@
+package infobip
+
+import (
+	"strings"
+	"unicode/utf16"
+)
+
+// Encoding is the character encoding a message is sent with.
+type Encoding string
+
+// Message encodings.
+const (
+	EncodingGSM7 Encoding = "GSM7"
+	EncodingUCS2 Encoding = "UCS2"
+)
+
+// Segment sizes, in septets for GSM-7 and in UTF-16 code units for UCS-2.
+// Messages split into many segments lose a part of each segment to the
+// concatenation header.
+const (
+	gsm7SingleSegment    = 160
+	gsm7MultipartSegment = 153
+	ucs2SingleSegment    = 70
+	ucs2MultipartSegment = 67
+)
+
+// gsm7Basic is the GSM 03.38 basic character set, without the escape character.
+const gsm7Basic = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+
+// gsm7Extended is the GSM 03.38 extension table, each character takes two septets.
+const gsm7Extended = "\f^{}\\[~]|€"
+
+// SegmentInfo describes how a text is split into message segments.
+// "Characters" is the length of the text in the chosen encoding, every character
+// of the GSM-7 extension table counts twice because of its escape.
+// "Remaining" is the number of characters left in the last segment.
+// "UnicodeCharacters" lists characters which forced UCS-2 encoding.
+type SegmentInfo struct {
+	Encoding          Encoding
+	Characters        int
+	Segments          int
+	PerSegment        int
+	Remaining         int
+	UnicodeCharacters []rune
+}
+
+// AnalyzeText returns the encoding and segment count of the text, the same
+// way they are counted by mobile networks. Empty text takes one segment.
+func AnalyzeText(text string) SegmentInfo {
+
+	info := SegmentInfo{Encoding: EncodingGSM7}
+
+	seen := make(map[rune]bool)
+	for _, r := range text {
+		if isGSM7(r) || seen[r] {
+			continue
+		}
+		seen[r] = true
+		info.Encoding = EncodingUCS2
+		info.UnicodeCharacters = append(info.UnicodeCharacters, r)
+	}
+
+	// length of every character in encoding units, the units of
+	// a single character can't be split between segments
+	var units []int
+	for _, r := range text {
+		switch {
+		case info.Encoding == EncodingUCS2:
+			units = append(units, len(utf16.Encode([]rune{r})))
+		case strings.ContainsRune(gsm7Extended, r):
+			units = append(units, 2)
+		default:
+			units = append(units, 1)
+		}
+	}
+
+	single, multipart := gsm7SingleSegment, gsm7MultipartSegment
+	if info.Encoding == EncodingUCS2 {
+		single, multipart = ucs2SingleSegment, ucs2MultipartSegment
+	}
+
+	for _, u := range units {
+		info.Characters += u
+	}
+
+	if info.Characters <= single {
+		info.Segments = 1
+		info.PerSegment = single
+		info.Remaining = single - info.Characters
+		return info
+	}
+
+	info.Segments = 1
+	info.PerSegment = multipart
+	current := 0
+	for _, u := range units {
+		if current+u > multipart {
+			info.Segments++
+			current = 0
+		}
+		current += u
+	}
+	info.Remaining = multipart - current
+
+	return info
+}
+
+// AnalyzeText returns the encoding and segment count of the message text.
+func (s *SMS) AnalyzeText() SegmentInfo {
+	return AnalyzeText(s.Text)
+}
+
+// isGSM7 reports whether the character can be sent with GSM-7 encoding.
+func isGSM7(r rune) bool {
+	return strings.ContainsRune(gsm7Basic, r) || strings.ContainsRune(gsm7Extended, r)
+}
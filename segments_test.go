@@ -0,0 +1,51 @@
+package infobip_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestAnalyzeText(t *testing.T) {
+	cases := []struct {
+		text       string
+		encoding   infobip.Encoding
+		characters int
+		segments   int
+		remaining  int
+	}{
+		{"", infobip.EncodingGSM7, 0, 1, 160},
+		{"Hello, World!", infobip.EncodingGSM7, 13, 1, 147},
+		{strings.Repeat("a", 160), infobip.EncodingGSM7, 160, 1, 0},
+		{strings.Repeat("a", 161), infobip.EncodingGSM7, 161, 2, 145},
+		{"Price: 10€", infobip.EncodingGSM7, 11, 1, 149},
+		// the escaped character doesn't fit into the first segment
+		{strings.Repeat("a", 152) + "€" + strings.Repeat("a", 10), infobip.EncodingGSM7, 164, 2, 141},
+		{"Привет", infobip.EncodingUCS2, 6, 1, 64},
+		{strings.Repeat("ж", 71), infobip.EncodingUCS2, 71, 2, 63},
+		{"Hi 😀", infobip.EncodingUCS2, 5, 1, 65},
+	}
+
+	for _, tc := range cases {
+		info := infobip.AnalyzeText(tc.text)
+		if info.Encoding != tc.encoding || info.Characters != tc.characters ||
+			info.Segments != tc.segments || info.Remaining != tc.remaining {
+			t.Fatalf("unexpected analysis of %q: %+v", tc.text, info)
+		}
+	}
+}
+
+func TestAnalyzeTextUnicodeCharacters(t *testing.T) {
+	sms := infobip.SMS{Text: "Café ✓ naïve ✓"}
+
+	info := sms.AnalyzeText()
+	if info.Encoding != infobip.EncodingUCS2 {
+		t.Fatalf("unexpected encoding: %s", info.Encoding)
+	}
+
+	// é is a GSM-7 character, ï and ✓ are not
+	if string(info.UnicodeCharacters) != "✓ï" {
+		t.Fatalf("unexpected unicode characters: %q", string(info.UnicodeCharacters))
+	}
+}
@@ -0,0 +1,110 @@
+package infobip
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// WithSessionRenewal makes the client remember the credentials passed to
+// Authenticate. When the IBSSO session expires, the client logs in again
+// and repeats the failed request once.
+func WithSessionRenewal() Option {
+	return func(c *Client) error {
+		c.renewSessions = true
+		return nil
+	}
+}
+
+// Logout allows you to invalidate the current IBSSO session.
+// Clients using other authentication modes have no session
+// to invalidate and keep their credentials.
+func (c *Client) Logout() error {
+	return c.LogoutContext(context.Background())
+}
+
+// LogoutContext is like Logout but uses the given context
+// for the underlying HTTP request.
+func (c *Client) LogoutContext(ctx context.Context) error {
+
+	auth, ok := c.currentAuthenticator().(*Auth)
+	if !ok {
+		return errors.New("no IBSSO session to log out")
+	}
+
+	req, err := c.newRequest(ctx, "DELETE", c.baseURL+sessionEndpoint, jsonContentType, nil)
+	if err != nil {
+		return err
+	}
+
+	err = c.roundTrip(req, auth, nil)
+	if err != nil {
+		return err
+	}
+
+	c.setSession(nil, "", "")
+
+	return nil
+}
+
+func (c *Client) currentAuthenticator() Authenticator {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.authenticator
+}
+
+func (c *Client) setAuthenticator(auth Authenticator) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.authenticator = auth
+}
+
+// setSession stores the IBSSO session along with the credentials used to create it,
+// credentials are kept only when session renewal is enabled.
+func (c *Client) setSession(auth *Auth, username, password string) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	// avoid storing a typed nil in the interface
+	if auth == nil {
+		c.authenticator = nil
+	} else {
+		c.authenticator = auth
+	}
+
+	if c.renewSessions {
+		c.username = username
+		c.password = password
+	}
+}
+
+// canRenewSession reports whether a request signed with auth
+// may be repeated with a fresh IBSSO session.
+func (c *Client) canRenewSession(auth Authenticator) bool {
+	if _, ok := auth.(*Auth); !ok {
+		return false
+	}
+
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.renewSessions && len(c.username) > 0
+}
+
+// renewSession creates a new IBSSO session replacing the expired one.
+// Concurrent callers holding the same expired session wait for
+// a single renewal instead of logging in on their own.
+func (c *Client) renewSession(ctx context.Context, expired Authenticator) error {
+	c.renewMu.Lock()
+	defer c.renewMu.Unlock()
+
+	if c.currentAuthenticator() != expired {
+		// somebody has already renewed the session
+		return nil
+	}
+
+	c.authMu.RLock()
+	username, password := c.username, c.password
+	c.authMu.RUnlock()
+
+	return c.AuthenticateContext(ctx, username, password)
+}
@@ -0,0 +1,109 @@
+package infobip_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestSessionRenewal(t *testing.T) {
+	var sessions, logouts int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/1/session", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "DELETE" {
+			atomic.AddInt32(&logouts, 1)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		n := atomic.AddInt32(&sessions, 1)
+		fmt.Fprintf(w, `{"token": "token-%d"}`, n)
+	})
+	mux.HandleFunc("/sms/1/reports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// the first session is already expired
+		if r.Header.Get("Authorization") == "IBSSO token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, fixture("unauthorized-response.json"))
+			return
+		}
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	c, err := infobip.NewClient("fake", "fake", infobip.BaseURL(s.URL), infobip.WithSessionRenewal())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetDeliveryReport("1"); err != nil {
+				t.Error(err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	if sessions != 2 {
+		t.Fatalf("expected a single renewal, got %d sessions", sessions)
+	}
+
+	if err := c.Logout(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if logouts != 1 {
+		t.Fatalf("logout was not called")
+	}
+}
+
+func TestAuthenticateUnauthorized(t *testing.T) {
+	c, tearDown := failingAPI(t, http.StatusUnauthorized, "unauthorized-response.json")
+	defer tearDown()
+
+	if err := c.Authenticate("fake", "fake"); !infobip.IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+}
+
+func TestLogoutWithoutSession(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Authorization") != "App secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("delivery-report-response.json"))
+	}))
+	defer s.Close()
+
+	c, err := infobip.New(infobip.BaseURL(s.URL), infobip.WithAPIKey("secret"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := c.Logout(); err == nil {
+		t.Fatal("Should fail without IBSSO session")
+	}
+
+	if calls != 0 {
+		t.Fatalf("logout must not be sent, got %d calls", calls)
+	}
+
+	if _, err := c.GetDeliveryReport("1"); err != nil {
+		t.Fatalf("API key must be kept after logout: %v", err)
+	}
+}
@@ -6,16 +6,98 @@ import (
 )
 
 // SMS is a message that will be sent.
-// "From" field should be alphanumeric sender ID, the length of data should be between 3 and 11 characters.
+// "From" field should be alphanumeric sender ID, the length of data should be between 3 and 11 characters,
+// or numeric sender ID up to 16 digits.
 // "To" is an array of message destination addresses in international format.
 // "Text" is a message body.
+// "MessageID" is an optional caller-provided message ID, when set failed sends are
+// retried according to the client retry policy.
 type SMS struct {
-	From string   `json:"from"` // todo: validate length
-	To   []string `json:"to"`
-	Text string   `json:"text"`
+	From      string   `json:"from"`
+	To        []string `json:"to"`
+	Text      string   `json:"text"`
+	MessageID string   `json:"messageId,omitempty"`
 }
 
 func (s *SMS) buffer() *bytes.Buffer {
 	b, _ := json.Marshal(s)
 	return bytes.NewBuffer(b)
 }
+
+// multiSMS is a request sending many single textual messages at once.
+type multiSMS struct {
+	Messages []SMS `json:"messages"`
+}
+
+func (s *multiSMS) buffer() *bytes.Buffer {
+	b, _ := json.Marshal(s)
+	return bytes.NewBuffer(b)
+}
+
+// Destination is a message destination address with an optional
+// caller-provided message ID.
+type Destination struct {
+	To        string `json:"to"`
+	MessageID string `json:"messageId,omitempty"`
+}
+
+// Language sets the language used for sending messages with national language tables.
+type Language struct {
+	LanguageCode string `json:"languageCode"`
+}
+
+// AdvancedMessage is a single message of the AdvancedSMS request.
+// "NotifyURL" is the URL delivery reports are pushed to, in the format set by "NotifyContentType".
+// "ValidityPeriod" is the message validity in minutes.
+// "SendAt" schedules the message for the given time.
+// "Transliteration" replaces characters not supported by the GSM 7-bit alphabet, e.g. "TURKISH".
+type AdvancedMessage struct {
+	From              string        `json:"from,omitempty"`
+	Destinations      []Destination `json:"destinations"`
+	Text              string        `json:"text"`
+	Flash             bool          `json:"flash,omitempty"`
+	Language          *Language     `json:"language,omitempty"`
+	Transliteration   string        `json:"transliteration,omitempty"`
+	NotifyURL         string        `json:"notifyUrl,omitempty"`
+	NotifyContentType string        `json:"notifyContentType,omitempty"`
+	CallbackData      string        `json:"callbackData,omitempty"`
+	ValidityPeriod    int           `json:"validityPeriod,omitempty"`
+	SendAt            *Time         `json:"sendAt,omitempty"`
+}
+
+// AdvancedSMS is a request sending many messages, each with its own text and destinations.
+// "BulkID" is an optional caller-provided ID of the whole request.
+type AdvancedSMS struct {
+	BulkID   string            `json:"bulkId,omitempty"`
+	Messages []AdvancedMessage `json:"messages"`
+}
+
+func (m AdvancedMessage) destinations() []Destination {
+	return m.Destinations
+}
+
+// advancedMessage is a message of an advanced request.
+type advancedMessage interface {
+	destinations() []Destination
+}
+
+// hasMessageIDs reports whether there are messages and every destination
+// has a caller-provided message ID, so sending them may be safely repeated.
+func hasMessageIDs[M advancedMessage](messages []M) bool {
+	for _, m := range messages {
+		if len(m.destinations()) < 1 {
+			return false
+		}
+		for _, d := range m.destinations() {
+			if len(d.MessageID) < 1 {
+				return false
+			}
+		}
+	}
+	return len(messages) > 0
+}
+
+func (s *AdvancedSMS) buffer() *bytes.Buffer {
+	b, _ := json.Marshal(s)
+	return bytes.NewBuffer(b)
+}
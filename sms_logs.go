@@ -0,0 +1,297 @@
+package infobip
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const logsEndpoint = "/sms/1/logs"
+
+// maxLogsLimit is the maximum number of logs returned by a single call.
+const maxLogsLimit = 1000
+
+// SmsLog is a log entry of a sent message.
+type SmsLog struct {
+	BulkID    string        `json:"bulkId"`
+	MessageID string        `json:"messageId"`
+	To        string        `json:"to"`
+	From      string        `json:"from"`
+	Text      string        `json:"text"`
+	SentAt    Time          `json:"sentAt"`
+	DoneAt    Time          `json:"doneAt"`
+	SmsCount  int           `json:"smsCount"`
+	MccMnc    string        `json:"mccMnc"`
+	Price     SentSmsPrice  `json:"price"`
+	Status    SentSmsStatus `json:"status"`
+	Error     SentSmsError  `json:"error"`
+}
+
+// SmsLogsResponse contains a collection of logs, one per every message.
+type SmsLogsResponse struct {
+	Results []SmsLog `json:"results"`
+}
+
+// SmsLogsQuery filters SMS logs. Zero values are not sent to the API.
+type SmsLogsQuery struct {
+	From          string
+	To            string
+	BulkID        []string
+	MessageID     []string
+	GeneralStatus StatusGroup
+	SentSince     time.Time
+	SentUntil     time.Time
+	Mcc           string
+	Mnc           string
+	Limit         int
+}
+
+// encode returns URL-encoded query string, including the leading "?" when not empty.
+func (q *SmsLogsQuery) encode() string {
+	v := url.Values{}
+	if len(q.From) > 0 {
+		v.Set("from", q.From)
+	}
+	if len(q.To) > 0 {
+		v.Set("to", q.To)
+	}
+	for _, id := range q.BulkID {
+		v.Add("bulkId", id)
+	}
+	for _, id := range q.MessageID {
+		v.Add("messageId", id)
+	}
+	if len(q.GeneralStatus) > 0 {
+		v.Set("generalStatus", string(q.GeneralStatus))
+	}
+	if !q.SentSince.IsZero() {
+		v.Set("sentSince", q.SentSince.Format(timeLayout))
+	}
+	if !q.SentUntil.IsZero() {
+		v.Set("sentUntil", q.SentUntil.Format(timeLayout))
+	}
+	if len(q.Mcc) > 0 {
+		v.Set("mcc", q.Mcc)
+	}
+	if len(q.Mnc) > 0 {
+		v.Set("mnc", q.Mnc)
+	}
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+
+	if len(v) < 1 {
+		return ""
+	}
+	return "?" + v.Encode()
+}
+
+// GetSMSLogs allows you to get logs of sent messages matching the query.
+// Unlike delivery reports, logs can be fetched many times.
+func (c *Client) GetSMSLogs(query SmsLogsQuery) (*SmsLogsResponse, error) {
+	return c.GetSMSLogsContext(context.Background(), query)
+}
+
+// GetSMSLogsContext is like GetSMSLogs but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetSMSLogsContext(ctx context.Context, query SmsLogsQuery) (*SmsLogsResponse, error) {
+
+	if query.Limit < 0 || query.Limit > maxLogsLimit {
+		return nil, errors.Errorf("limit must be between 0 and %d", maxLogsLimit)
+	}
+
+	res := SmsLogsResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+logsEndpoint+query.encode(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ErrLogsTruncated is returned by the iterator when more logs than the limit
+// were sent within a single millisecond, so they can't be split into windows.
+var ErrLogsTruncated = errors.New("more logs sent within a millisecond than the limit")
+
+// timeWindow is a half-open time range [since, until).
+type timeWindow struct {
+	since time.Time
+	until time.Time
+}
+
+// filter drops logs sent at the end of the window, they belong to the next one.
+func (w timeWindow) filter(logs []SmsLog) []SmsLog {
+
+	res := make([]SmsLog, 0, len(logs))
+	for _, l := range logs {
+		if l.SentAt.IsZero() || l.SentAt.Before(w.until) {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// SmsLogIterator pages through SMS logs by time windows.
+// A window returning a full page is split in halves and fetched again,
+// down to the millisecond precision of the API. When a single millisecond
+// still returns a full page, its logs are returned and the iteration stops
+// with ErrLogsTruncated.
+//
+// Windows are half-open: a log sent exactly at the end of a window is
+// returned once, by the next window, and logs sent exactly at
+// query.SentUntil are not returned, whether the API treats sentUntil
+// as inclusive or not.
+//
+//	it := client.IterateSMSLogs(query, time.Hour)
+//	for it.Next(ctx) {
+//		log := it.Log()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type SmsLogIterator struct {
+	client *Client
+	query  SmsLogsQuery
+	// windows are split halves waiting to be fetched,
+	// next windows of the given size are made on demand
+	windows []timeWindow
+	since   time.Time
+	until   time.Time
+	window  time.Duration
+	page    []SmsLog
+	current SmsLog
+	err     error
+}
+
+// IterateSMSLogs returns an iterator over logs sent between query.SentSince and
+// query.SentUntil, fetched in windows of the given size. Missing SentUntil means now.
+func (c *Client) IterateSMSLogs(query SmsLogsQuery, window time.Duration) *SmsLogIterator {
+
+	it := &SmsLogIterator{client: c, query: query}
+
+	if query.SentSince.IsZero() {
+		it.err = errors.New("sent since must be specified")
+		return it
+	}
+	if window <= 0 {
+		it.err = errors.New("window must be positive")
+		return it
+	}
+
+	until := query.SentUntil
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if it.query.Limit < 1 {
+		it.query.Limit = maxLogsLimit
+	}
+
+	// the API works with milliseconds, keep every window boundary on one
+	until = until.Truncate(time.Millisecond)
+	if window = window.Truncate(time.Millisecond); window < time.Millisecond {
+		window = time.Millisecond
+	}
+
+	it.since = query.SentSince.Truncate(time.Millisecond)
+	it.until = until
+	it.window = window
+
+	return it
+}
+
+// nextWindow returns the window to fetch next, false when there are no more.
+func (it *SmsLogIterator) nextWindow() (timeWindow, bool) {
+	if len(it.windows) > 0 {
+		return it.windows[0], true
+	}
+
+	if !it.since.Before(it.until) {
+		return timeWindow{}, false
+	}
+
+	end := it.since.Add(it.window)
+	if end.After(it.until) {
+		end = it.until
+	}
+	it.windows = append(it.windows, timeWindow{since: it.since, until: end})
+	it.since = end
+
+	return it.windows[0], true
+}
+
+// Next advances the iterator to the next log, fetching more logs when needed.
+// It returns false when there are no more logs, an error occurs or the context is done.
+func (it *SmsLogIterator) Next(ctx context.Context) bool {
+
+	for len(it.page) < 1 {
+		if it.err != nil {
+			return false
+		}
+
+		window, ok := it.nextWindow()
+		if !ok {
+			return false
+		}
+
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		query := it.query
+		query.SentSince = window.since
+		query.SentUntil = window.until
+
+		res, err := it.client.GetSMSLogsContext(ctx, query)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		// a full page may be truncated, fetch both halves of the window instead
+		size := window.until.Sub(window.since)
+		if len(res.Results) >= query.Limit && size > time.Millisecond {
+			middle := window.since.Add((size / 2).Truncate(time.Millisecond))
+			it.windows = append([]timeWindow{
+				{since: window.since, until: middle},
+				{since: middle, until: window.until},
+			}, it.windows[1:]...)
+			continue
+		}
+
+		// a millisecond can't be split, but the page may be filled with logs
+		// of the next one, fetch exactly this millisecond then
+		if len(res.Results) >= query.Limit && len(window.filter(res.Results)) < len(res.Results) {
+			query.SentUntil = window.since
+			res, err = it.client.GetSMSLogsContext(ctx, query)
+			if err != nil {
+				it.err = err
+				return false
+			}
+		}
+		if len(res.Results) >= query.Limit {
+			it.err = ErrLogsTruncated
+		}
+
+		it.windows = it.windows[1:]
+		it.page = window.filter(res.Results)
+	}
+
+	it.current = it.page[0]
+	it.page = it.page[1:]
+
+	return true
+}
+
+// Log returns the current log.
+func (it *SmsLogIterator) Log() SmsLog {
+	return it.current
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *SmsLogIterator) Err() error {
+	return it.err
+}
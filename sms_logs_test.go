@@ -0,0 +1,221 @@
+package infobip_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+const layout = "2006-01-02T15:04:05.000-0700"
+
+func TestGetSMSLogs(t *testing.T) {
+	var query string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("sms-logs-response.json"))
+	}))
+	defer s.Close()
+
+	c, err := infobip.New(infobip.BaseURL(s.URL))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	res, err := c.GetSMSLogs(infobip.SmsLogsQuery{
+		From:          "InfoSMS",
+		GeneralStatus: infobip.StatusDelivered,
+		SentSince:     time.Date(2015, 2, 22, 17, 42, 5, 390000000, time.FixedZone("CET", 3600)),
+		Limit:         10,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(res.Results) != 1 || res.Results[0].Text != "Test SMS." {
+		t.Fatalf("unexpected response: %+v", res)
+	}
+
+	expected := "from=InfoSMS&generalStatus=DELIVERED&limit=10&sentSince=2015-02-22T17%3A42%3A05.390%2B0100"
+	if query != expected {
+		t.Fatalf("expected query %q, got %q", expected, query)
+	}
+}
+
+func TestSmsLogIterator(t *testing.T) {
+	start := time.Date(2015, 2, 22, 0, 0, 0, 0, time.UTC)
+
+	// fake logs API with one log every 10 minutes
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		since, _ := time.Parse(layout, q.Get("sentSince"))
+		until, _ := time.Parse(layout, q.Get("sentUntil"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		results := []map[string]string{}
+		for i := 0; i < 12 && len(results) < limit; i++ {
+			at := start.Add(time.Duration(i) * 10 * time.Minute)
+			if !at.Before(since) && at.Before(until) {
+				results = append(results, map[string]string{"messageId": strconv.Itoa(i)})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+	}))
+	defer s.Close()
+
+	c, err := infobip.New(infobip.BaseURL(s.URL))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	query := infobip.SmsLogsQuery{
+		SentSince: start,
+		SentUntil: start.Add(2 * time.Hour),
+		Limit:     2,
+	}
+
+	it := c.IterateSMSLogs(query, time.Hour)
+	seen := 0
+	for it.Next(context.Background()) {
+		if it.Log().MessageID != strconv.Itoa(seen) {
+			t.Fatalf("unexpected log %s at position %d", it.Log().MessageID, seen)
+		}
+		seen++
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if seen != 12 {
+		t.Fatalf("expected 12 logs, got %d", seen)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it = c.IterateSMSLogs(query, time.Hour)
+	if it.Next(ctx) || it.Err() != context.Canceled {
+		t.Fatalf("iterator must stop when context is done: %v", it.Err())
+	}
+}
+
+// logsAPI fakes the logs API with an inclusive sentUntil, returning logs sent at the given times.
+func logsAPI(t *testing.T, times []time.Time) *infobip.Client {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		since, _ := time.Parse(layout, q.Get("sentSince"))
+		until, _ := time.Parse(layout, q.Get("sentUntil"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		results := []map[string]string{}
+		for i, at := range times {
+			if len(results) < limit && !at.Before(since) && !at.After(until) {
+				results = append(results, map[string]string{
+					"messageId": strconv.Itoa(i),
+					"sentAt":    at.Format(layout),
+				})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := infobip.New(infobip.BaseURL(s.URL))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return c
+}
+
+func TestSmsLogIteratorBoundaries(t *testing.T) {
+	start := time.Date(2015, 2, 22, 0, 0, 0, 0, time.UTC)
+
+	// logs every 10 minutes, some of them exactly at window boundaries
+	times := []time.Time{}
+	for i := 0; i < 12; i++ {
+		times = append(times, start.Add(time.Duration(i)*10*time.Minute))
+	}
+	c := logsAPI(t, times)
+
+	it := c.IterateSMSLogs(infobip.SmsLogsQuery{
+		SentSince: start,
+		SentUntil: start.Add(2 * time.Hour),
+		Limit:     4,
+	}, time.Hour)
+
+	seen := map[string]int{}
+	for it.Next(context.Background()) {
+		seen[it.Log().MessageID]++
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for i := range times {
+		if n := seen[strconv.Itoa(i)]; n != 1 {
+			t.Fatalf("expected log %d once, got %d times", i, n)
+		}
+	}
+}
+
+func TestSmsLogIteratorTruncated(t *testing.T) {
+	start := time.Date(2015, 2, 22, 0, 0, 0, 0, time.UTC)
+
+	// a bulk of 5 logs sent within the same millisecond
+	times := []time.Time{start}
+	for i := 0; i < 5; i++ {
+		times = append(times, start.Add(time.Minute))
+	}
+	c := logsAPI(t, times)
+
+	it := c.IterateSMSLogs(infobip.SmsLogsQuery{
+		SentSince: start,
+		SentUntil: start.Add(time.Hour),
+		Limit:     2,
+	}, time.Hour)
+
+	seen := 0
+	for it.Next(context.Background()) {
+		seen++
+	}
+
+	if !errors.Is(it.Err(), infobip.ErrLogsTruncated) {
+		t.Fatalf("expected truncation error, got %v", it.Err())
+	}
+
+	// the first log and the full page of the truncated millisecond
+	if seen != 3 {
+		t.Fatalf("expected 3 logs, got %d", seen)
+	}
+}
+
+func TestSmsLogIteratorLongRange(t *testing.T) {
+	c, err := infobip.New()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// windows are made on demand, so a long range with tiny windows costs nothing up front
+	it := c.IterateSMSLogs(infobip.SmsLogsQuery{SentSince: time.Unix(0, 0)}, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if it.Next(ctx) || it.Err() != context.Canceled {
+		t.Fatalf("iterator must stop when context is done: %v", it.Err())
+	}
+}
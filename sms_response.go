@@ -3,11 +3,11 @@ package infobip
 // SmsResponseStatus indicates whether the message is successfully sent, not sent,
 // delivered, not delivered, waiting for delivery or any other possible status.
 type SmsResponseStatus struct {
-	GroupID     int    `json:"groupId"`
-	GroupName   string `json:"groupName"`
-	ID          int    `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	GroupID     int         `json:"groupId"`
+	GroupName   StatusGroup `json:"groupName"`
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
 }
 
 // SmsResponseDetails contains info about every message.
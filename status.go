@@ -0,0 +1,85 @@
+package infobip
+
+// StatusGroup is a group of message statuses.
+type StatusGroup string
+
+// Message status groups.
+const (
+	StatusPending       StatusGroup = "PENDING"
+	StatusUndeliverable StatusGroup = "UNDELIVERABLE"
+	StatusDelivered     StatusGroup = "DELIVERED"
+	StatusExpired       StatusGroup = "EXPIRED"
+	StatusRejected      StatusGroup = "REJECTED"
+)
+
+// statusGroupsByID maps status group IDs to their names.
+var statusGroupsByID = map[int]StatusGroup{
+	1: StatusPending,
+	2: StatusUndeliverable,
+	3: StatusDelivered,
+	4: StatusExpired,
+	5: StatusRejected,
+}
+
+// IsFinal reports whether the message status won't change anymore.
+func (g StatusGroup) IsFinal() bool {
+	switch g {
+	case StatusUndeliverable, StatusDelivered, StatusExpired, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsSuccess reports whether the message has been delivered.
+func (g StatusGroup) IsSuccess() bool {
+	return g == StatusDelivered
+}
+
+// statusGroup returns the group name, falling back to the group ID when the name is missing.
+func statusGroup(id int, name StatusGroup) StatusGroup {
+	if len(name) > 0 {
+		return name
+	}
+	return statusGroupsByID[id]
+}
+
+// ErrorGroup is a group of message errors.
+type ErrorGroup string
+
+// Message error groups.
+const (
+	ErrorGroupOK       ErrorGroup = "OK"
+	ErrorGroupHandset  ErrorGroup = "HANDSET_ERRORS"
+	ErrorGroupUser     ErrorGroup = "USER_ERRORS"
+	ErrorGroupOperator ErrorGroup = "OPERATOR_ERRORS"
+)
+
+// IsFinal reports whether the message status won't change anymore.
+func (s *SentSmsStatus) IsFinal() bool {
+	return statusGroup(s.GroupID, s.GroupName).IsFinal()
+}
+
+// IsSuccess reports whether the message has been delivered.
+func (s *SentSmsStatus) IsSuccess() bool {
+	return statusGroup(s.GroupID, s.GroupName).IsSuccess()
+}
+
+// IsFinal reports whether the message status won't change anymore.
+func (s *SmsResponseStatus) IsFinal() bool {
+	return statusGroup(s.GroupID, s.GroupName).IsFinal()
+}
+
+// IsSuccess reports whether the message has been delivered.
+func (s *SmsResponseStatus) IsSuccess() bool {
+	return statusGroup(s.GroupID, s.GroupName).IsSuccess()
+}
+
+// IsFinal reports whether the error is permanent and the message won't be delivered.
+func (e *SentSmsError) IsFinal() bool {
+	return e.Permanent
+}
+
+// IsSuccess reports whether no error has occurred.
+func (e *SentSmsError) IsSuccess() bool {
+	return e.GroupID == 0 && (len(e.GroupName) < 1 || e.GroupName == ErrorGroupOK)
+}
@@ -0,0 +1,489 @@
+package infobip
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const tfaApplicationsEndpoint = "/2fa/2/applications"
+const tfaPinEndpoint = "/2fa/2/pin"
+
+// PIN errors reported by VerifyPIN, see PINError.
+const (
+	PINErrorWrong           = "WRONG_PIN"
+	PINErrorTooManyAttempts = "NO_MORE_PIN_ATTEMPTS"
+	PINErrorExpired         = "PIN_EXPIRED"
+)
+
+// PINType is the type of characters a PIN is made of.
+type PINType string
+
+// PIN types.
+const (
+	PINNumeric      PINType = "NUMERIC"
+	PINAlpha        PINType = "ALPHA"
+	PINHex          PINType = "HEX"
+	PINAlphanumeric PINType = "ALPHANUMERIC"
+)
+
+// TFAConfiguration sets the limits of a 2FA application.
+// Time to live and limit values use the API format, e.g. "15m" or "5/1d".
+// Unset values keep their defaults or current settings.
+type TFAConfiguration struct {
+	PinAttempts                   int    `json:"pinAttempts,omitempty"`
+	AllowMultiplePinVerifications *bool  `json:"allowMultiplePinVerifications,omitempty"`
+	PinTimeToLive                 string `json:"pinTimeToLive,omitempty"`
+	VerifyPinLimit                string `json:"verifyPinLimit,omitempty"`
+	SendPinPerApplicationLimit    string `json:"sendPinPerApplicationLimit,omitempty"`
+	SendPinPerPhoneNumberLimit    string `json:"sendPinPerPhoneNumberLimit,omitempty"`
+}
+
+// TFAApplication is a 2FA application, it holds the configuration
+// and the message templates used to send PINs. Unset "Enabled" keeps
+// the current state, new applications are enabled by default.
+type TFAApplication struct {
+	ApplicationID string            `json:"applicationId,omitempty"`
+	Name          string            `json:"name"`
+	Enabled       *bool             `json:"enabled,omitempty"`
+	Configuration *TFAConfiguration `json:"configuration,omitempty"`
+}
+
+// TFAMessageTemplate is a template of messages PINs are sent with.
+// "MessageText" must contain the {{pin}} placeholder.
+type TFAMessageTemplate struct {
+	MessageID     string  `json:"messageId,omitempty"`
+	ApplicationID string  `json:"applicationId,omitempty"`
+	PinType       PINType `json:"pinType"`
+	PinLength     int     `json:"pinLength"`
+	MessageText   string  `json:"messageText"`
+	Language      string  `json:"language,omitempty"`
+	SenderID      string  `json:"senderId,omitempty"`
+	RepeatDTMF    string  `json:"repeatDTMF,omitempty"`
+	SpeechRate    float64 `json:"speechRate,omitempty"`
+}
+
+// PINRequest sends a PIN to the phone number with the given application and template.
+// "Placeholders" fill the custom placeholders of the template.
+type PINRequest struct {
+	ApplicationID string            `json:"applicationId"`
+	MessageID     string            `json:"messageId"`
+	From          string            `json:"from,omitempty"`
+	To            string            `json:"to"`
+	Placeholders  map[string]string `json:"placeholders,omitempty"`
+}
+
+// PINResponse identifies the sent PIN, "SmsStatus" is set for PINs sent
+// over SMS and "CallStatus" for voice calls.
+type PINResponse struct {
+	PinID      string `json:"pinId"`
+	To         string `json:"to"`
+	NcStatus   string `json:"ncStatus"`
+	SmsStatus  string `json:"smsStatus"`
+	CallStatus string `json:"callStatus"`
+}
+
+// VerifyPINResponse is the result of a PIN verification.
+type VerifyPINResponse struct {
+	PinID             string `json:"pinId"`
+	Msisdn            string `json:"msisdn"`
+	Verified          bool   `json:"verified"`
+	AttemptsRemaining int    `json:"attemptsRemaining"`
+	PinError          string `json:"pinError"`
+}
+
+// Verification is the verification status of a phone number.
+type Verification struct {
+	Msisdn     string `json:"msisdn"`
+	Verified   bool   `json:"verified"`
+	VerifiedAt Time   `json:"verifiedAt"`
+	SentAt     Time   `json:"sentAt"`
+}
+
+// verificationsResponse is the response of the verification status request.
+type verificationsResponse struct {
+	Verifications []Verification `json:"verifications"`
+}
+
+// PINError is returned by VerifyPIN when the API answers that the PIN
+// is not verified, "PinError" is one of the PINError constants.
+type PINError struct {
+	PinID             string
+	PinError          string
+	AttemptsRemaining int
+}
+
+// Error is an implementation of error interface for the PINError type.
+func (e *PINError) Error() string {
+	return e.PinError + ": PIN verification failed, " + strconv.Itoa(e.AttemptsRemaining) + " attempts remaining"
+}
+
+// IsWrongPIN reports whether err is caused by a wrong PIN.
+func IsWrongPIN(err error) bool {
+	return hasErrorCode(err, PINErrorWrong)
+}
+
+// IsTooManyPINAttempts reports whether err is caused by
+// exceeding the number of PIN verification attempts.
+func IsTooManyPINAttempts(err error) bool {
+	return hasErrorCode(err, PINErrorTooManyAttempts)
+}
+
+// IsPINExpired reports whether err is caused by an expired PIN.
+func IsPINExpired(err error) bool {
+	return hasErrorCode(err, PINErrorExpired)
+}
+
+func tfaApplicationPath(applicationID string) string {
+	return tfaApplicationsEndpoint + "/" + url.PathEscape(applicationID)
+}
+
+// CreateTFAApplication allows you to create a 2FA application.
+func (c *Client) CreateTFAApplication(app *TFAApplication) (*TFAApplication, error) {
+	return c.CreateTFAApplicationContext(context.Background(), app)
+}
+
+// CreateTFAApplicationContext is like CreateTFAApplication but uses the given context
+// for the underlying HTTP request.
+func (c *Client) CreateTFAApplicationContext(ctx context.Context, app *TFAApplication) (*TFAApplication, error) {
+
+	if len(app.Name) < 1 {
+		return nil, errors.New("application name must be specified")
+	}
+
+	payload, err := jsonBuffer(app)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TFAApplication{}
+	err = c.doRequest(ctx, "POST", c.baseURL+tfaApplicationsEndpoint, payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetTFAApplications allows you to get all 2FA applications.
+func (c *Client) GetTFAApplications() ([]TFAApplication, error) {
+	return c.GetTFAApplicationsContext(context.Background())
+}
+
+// GetTFAApplicationsContext is like GetTFAApplications but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetTFAApplicationsContext(ctx context.Context) ([]TFAApplication, error) {
+
+	res := []TFAApplication{}
+	err := c.doRequest(ctx, "GET", c.baseURL+tfaApplicationsEndpoint, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetTFAApplication allows you to get a 2FA application.
+func (c *Client) GetTFAApplication(applicationID string) (*TFAApplication, error) {
+	return c.GetTFAApplicationContext(context.Background(), applicationID)
+}
+
+// GetTFAApplicationContext is like GetTFAApplication but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetTFAApplicationContext(ctx context.Context, applicationID string) (*TFAApplication, error) {
+
+	if len(applicationID) < 1 {
+		return nil, errors.New("application ID must be specified")
+	}
+
+	res := TFAApplication{}
+	err := c.doRequest(ctx, "GET", c.baseURL+tfaApplicationPath(applicationID), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateTFAApplication allows you to change a 2FA application.
+func (c *Client) UpdateTFAApplication(applicationID string, app *TFAApplication) (*TFAApplication, error) {
+	return c.UpdateTFAApplicationContext(context.Background(), applicationID, app)
+}
+
+// UpdateTFAApplicationContext is like UpdateTFAApplication but uses the given context
+// for the underlying HTTP request.
+func (c *Client) UpdateTFAApplicationContext(ctx context.Context, applicationID string, app *TFAApplication) (*TFAApplication, error) {
+
+	if len(applicationID) < 1 {
+		return nil, errors.New("application ID must be specified")
+	}
+
+	payload, err := jsonBuffer(app)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TFAApplication{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+tfaApplicationPath(applicationID), payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// CreateTFAMessageTemplate allows you to create a message template of a 2FA application.
+func (c *Client) CreateTFAMessageTemplate(applicationID string, template *TFAMessageTemplate) (*TFAMessageTemplate, error) {
+	return c.CreateTFAMessageTemplateContext(context.Background(), applicationID, template)
+}
+
+// CreateTFAMessageTemplateContext is like CreateTFAMessageTemplate but uses the given context
+// for the underlying HTTP request.
+func (c *Client) CreateTFAMessageTemplateContext(ctx context.Context, applicationID string, template *TFAMessageTemplate) (*TFAMessageTemplate, error) {
+
+	if len(applicationID) < 1 {
+		return nil, errors.New("application ID must be specified")
+	}
+
+	if len(template.MessageText) < 1 || template.PinLength < 1 {
+		return nil, errors.New("message text and PIN length must be specified")
+	}
+
+	payload, err := jsonBuffer(template)
+	if err != nil {
+		return nil, err
+	}
+
+	res := TFAMessageTemplate{}
+	err = c.doRequest(ctx, "POST", c.baseURL+tfaApplicationPath(applicationID)+"/messages", payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetTFAMessageTemplates allows you to get all message templates of a 2FA application.
+func (c *Client) GetTFAMessageTemplates(applicationID string) ([]TFAMessageTemplate, error) {
+	return c.GetTFAMessageTemplatesContext(context.Background(), applicationID)
+}
+
+// GetTFAMessageTemplatesContext is like GetTFAMessageTemplates but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetTFAMessageTemplatesContext(ctx context.Context, applicationID string) ([]TFAMessageTemplate, error) {
+
+	if len(applicationID) < 1 {
+		return nil, errors.New("application ID must be specified")
+	}
+
+	res := []TFAMessageTemplate{}
+	err := c.doRequest(ctx, "GET", c.baseURL+tfaApplicationPath(applicationID)+"/messages", nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetTFAMessageTemplate allows you to get a message template of a 2FA application.
+func (c *Client) GetTFAMessageTemplate(applicationID, messageID string) (*TFAMessageTemplate, error) {
+	return c.GetTFAMessageTemplateContext(context.Background(), applicationID, messageID)
+}
+
+// GetTFAMessageTemplateContext is like GetTFAMessageTemplate but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetTFAMessageTemplateContext(ctx context.Context, applicationID, messageID string) (*TFAMessageTemplate, error) {
+
+	if len(applicationID) < 1 || len(messageID) < 1 {
+		return nil, errors.New("application ID and message ID must be specified")
+	}
+
+	path := tfaApplicationPath(applicationID) + "/messages/" + url.PathEscape(messageID)
+
+	res := TFAMessageTemplate{}
+	err := c.doRequest(ctx, "GET", c.baseURL+path, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// UpdateTFAMessageTemplate allows you to change a message template of a 2FA application.
+func (c *Client) UpdateTFAMessageTemplate(applicationID, messageID string, template *TFAMessageTemplate) (*TFAMessageTemplate, error) {
+	return c.UpdateTFAMessageTemplateContext(context.Background(), applicationID, messageID, template)
+}
+
+// UpdateTFAMessageTemplateContext is like UpdateTFAMessageTemplate but uses the given context
+// for the underlying HTTP request.
+func (c *Client) UpdateTFAMessageTemplateContext(ctx context.Context, applicationID, messageID string, template *TFAMessageTemplate) (*TFAMessageTemplate, error) {
+
+	if len(applicationID) < 1 || len(messageID) < 1 {
+		return nil, errors.New("application ID and message ID must be specified")
+	}
+
+	payload, err := jsonBuffer(template)
+	if err != nil {
+		return nil, err
+	}
+
+	path := tfaApplicationPath(applicationID) + "/messages/" + url.PathEscape(messageID)
+
+	res := TFAMessageTemplate{}
+	err = c.doRequest(ctx, "PUT", c.baseURL+path, payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SendPIN allows you to send a PIN over SMS.
+func (c *Client) SendPIN(pin *PINRequest) (*PINResponse, error) {
+	return c.SendPINContext(context.Background(), pin)
+}
+
+// SendPINContext is like SendPIN but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendPINContext(ctx context.Context, pin *PINRequest) (*PINResponse, error) {
+	return c.sendPIN(ctx, tfaPinEndpoint, pin)
+}
+
+// SendPINVoice allows you to send a PIN with a voice call.
+func (c *Client) SendPINVoice(pin *PINRequest) (*PINResponse, error) {
+	return c.SendPINVoiceContext(context.Background(), pin)
+}
+
+// SendPINVoiceContext is like SendPINVoice but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendPINVoiceContext(ctx context.Context, pin *PINRequest) (*PINResponse, error) {
+	return c.sendPIN(ctx, tfaPinEndpoint+"/voice", pin)
+}
+
+func (c *Client) sendPIN(ctx context.Context, endpoint string, pin *PINRequest) (*PINResponse, error) {
+
+	if len(pin.ApplicationID) < 1 || len(pin.MessageID) < 1 || len(pin.To) < 1 {
+		return nil, errors.New("application ID, message ID and destination must be specified")
+	}
+
+	payload, err := jsonBuffer(pin)
+	if err != nil {
+		return nil, err
+	}
+
+	res := PINResponse{}
+	err = c.doRequest(ctx, "POST", c.baseURL+endpoint, payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ResendPIN allows you to send the PIN once again over SMS.
+func (c *Client) ResendPIN(pinID string, placeholders map[string]string) (*PINResponse, error) {
+	return c.ResendPINContext(context.Background(), pinID, placeholders)
+}
+
+// ResendPINContext is like ResendPIN but uses the given context
+// for the underlying HTTP request.
+func (c *Client) ResendPINContext(ctx context.Context, pinID string, placeholders map[string]string) (*PINResponse, error) {
+	return c.resendPIN(ctx, pinID, "/resend", placeholders)
+}
+
+// ResendPINVoice allows you to send the PIN once again with a voice call.
+func (c *Client) ResendPINVoice(pinID string, placeholders map[string]string) (*PINResponse, error) {
+	return c.ResendPINVoiceContext(context.Background(), pinID, placeholders)
+}
+
+// ResendPINVoiceContext is like ResendPINVoice but uses the given context
+// for the underlying HTTP request.
+func (c *Client) ResendPINVoiceContext(ctx context.Context, pinID string, placeholders map[string]string) (*PINResponse, error) {
+	return c.resendPIN(ctx, pinID, "/resend/voice", placeholders)
+}
+
+func (c *Client) resendPIN(ctx context.Context, pinID string, suffix string, placeholders map[string]string) (*PINResponse, error) {
+
+	if len(pinID) < 1 {
+		return nil, errors.New("PIN ID must be specified")
+	}
+
+	payload, err := jsonBuffer(struct {
+		Placeholders map[string]string `json:"placeholders,omitempty"`
+	}{placeholders})
+	if err != nil {
+		return nil, err
+	}
+
+	res := PINResponse{}
+	err = c.doRequest(ctx, "POST", c.baseURL+tfaPinEndpoint+"/"+url.PathEscape(pinID)+suffix, payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// VerifyPIN allows you to verify the PIN entered by the user.
+// A failed verification returns both the response and a PINError,
+// see IsWrongPIN, IsTooManyPINAttempts and IsPINExpired.
+func (c *Client) VerifyPIN(pinID, pin string) (*VerifyPINResponse, error) {
+	return c.VerifyPINContext(context.Background(), pinID, pin)
+}
+
+// VerifyPINContext is like VerifyPIN but uses the given context
+// for the underlying HTTP request.
+func (c *Client) VerifyPINContext(ctx context.Context, pinID, pin string) (*VerifyPINResponse, error) {
+
+	if len(pinID) < 1 || len(pin) < 1 {
+		return nil, errors.New("PIN ID and PIN must be specified")
+	}
+
+	payload, err := jsonBuffer(map[string]string{"pin": pin})
+	if err != nil {
+		return nil, err
+	}
+
+	res := VerifyPINResponse{}
+	err = c.doRequest(ctx, "POST", c.baseURL+tfaPinEndpoint+"/"+url.PathEscape(pinID)+"/verify", payload, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if !res.Verified && len(res.PinError) > 0 {
+		return &res, &PINError{
+			PinID:             res.PinID,
+			PinError:          res.PinError,
+			AttemptsRemaining: res.AttemptsRemaining,
+		}
+	}
+
+	return &res, nil
+}
+
+// GetVerificationStatus allows you to check whether the phone number has been verified
+// with the 2FA application.
+func (c *Client) GetVerificationStatus(applicationID, msisdn string) ([]Verification, error) {
+	return c.GetVerificationStatusContext(context.Background(), applicationID, msisdn)
+}
+
+// GetVerificationStatusContext is like GetVerificationStatus but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetVerificationStatusContext(ctx context.Context, applicationID, msisdn string) ([]Verification, error) {
+
+	if len(applicationID) < 1 || len(msisdn) < 1 {
+		return nil, errors.New("application ID and phone number must be specified")
+	}
+
+	query := "?" + url.Values{"msisdn": {msisdn}}.Encode()
+
+	res := verificationsResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+tfaApplicationPath(applicationID)+"/verifications"+query, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Verifications, nil
+}
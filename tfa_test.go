@@ -0,0 +1,180 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestTFAApplications(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/2fa/2/applications", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "POST" {
+			app := infobip.TFAApplication{}
+			json.NewDecoder(r.Body).Decode(&app)
+			app.ApplicationID = "HJ675435E3A6EA43432G5F37A635KJ8B"
+			json.NewEncoder(w).Encode(app)
+			return
+		}
+		fmt.Fprint(w, `[{"applicationId": "HJ675435E3A6EA43432G5F37A635KJ8B", "name": "2fa test application", "enabled": true}]`)
+	})
+
+	mux.HandleFunc("/2fa/2/applications/HJ675435E3A6EA43432G5F37A635KJ8B/messages", func(w http.ResponseWriter, r *http.Request) {
+		template := infobip.TFAMessageTemplate{}
+		json.NewDecoder(r.Body).Decode(&template)
+		template.MessageID = "9C815F8AF3FAD6B7D4EBC4DA81F8F01E"
+		template.ApplicationID = "HJ675435E3A6EA43432G5F37A635KJ8B"
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(template)
+	})
+
+	enabled := true
+	app, err := client.CreateTFAApplication(&infobip.TFAApplication{
+		Name:    "2fa test application",
+		Enabled: &enabled,
+		Configuration: &infobip.TFAConfiguration{
+			PinAttempts:   10,
+			PinTimeToLive: "15m",
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if app.ApplicationID != "HJ675435E3A6EA43432G5F37A635KJ8B" || app.Configuration.PinTimeToLive != "15m" {
+		t.Fatalf("unexpected application: %+v", app)
+	}
+
+	apps, err := client.GetTFAApplications()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(apps) != 1 || apps[0].Enabled == nil || !*apps[0].Enabled {
+		t.Fatalf("unexpected applications: %+v", apps)
+	}
+
+	template, err := client.CreateTFAMessageTemplate(app.ApplicationID, &infobip.TFAMessageTemplate{
+		PinType:     infobip.PINNumeric,
+		PinLength:   4,
+		MessageText: "Your pin is {{pin}}",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if template.MessageID != "9C815F8AF3FAD6B7D4EBC4DA81F8F01E" || template.PinType != infobip.PINNumeric {
+		t.Fatalf("unexpected template: %+v", template)
+	}
+}
+
+func TestUpdateTFAApplication(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var payload map[string]interface{}
+	mux.HandleFunc("/2fa/2/applications/HJ675435E3A6EA43432G5F37A635KJ8B", func(w http.ResponseWriter, r *http.Request) {
+		payload = map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"applicationId": "HJ675435E3A6EA43432G5F37A635KJ8B", "name": "renamed", "enabled": true}`)
+	})
+
+	app, err := client.UpdateTFAApplication("HJ675435E3A6EA43432G5F37A635KJ8B", &infobip.TFAApplication{
+		Name:          "renamed",
+		Configuration: &infobip.TFAConfiguration{PinAttempts: 5},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// unset flags must not be sent as false
+	configuration := payload["configuration"].(map[string]interface{})
+	if _, ok := payload["enabled"]; ok {
+		t.Fatalf("enabled must not be sent: %+v", payload)
+	}
+	if _, ok := configuration["allowMultiplePinVerifications"]; ok {
+		t.Fatalf("allowMultiplePinVerifications must not be sent: %+v", payload)
+	}
+
+	if app.Name != "renamed" || app.Enabled == nil || !*app.Enabled {
+		t.Fatalf("unexpected application: %+v", app)
+	}
+}
+
+func TestPINVerification(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/2fa/2/pin", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"pinId": "9C817C6F8AF3D48F9FE553282AFA2B67", "to": "41793026727", "ncStatus": "NC_DESTINATION_REACHABLE", "smsStatus": "MESSAGE_SENT"}`)
+	})
+
+	mux.HandleFunc("/2fa/2/pin/9C817C6F8AF3D48F9FE553282AFA2B67/verify", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		if body["pin"] == "1598" {
+			fmt.Fprint(w, `{"pinId": "9C817C6F8AF3D48F9FE553282AFA2B67", "msisdn": "41793026727", "verified": true, "attemptsRemaining": 0}`)
+			return
+		}
+		fmt.Fprint(w, `{"pinId": "9C817C6F8AF3D48F9FE553282AFA2B67", "msisdn": "41793026727", "verified": false, "attemptsRemaining": 2, "pinError": "WRONG_PIN"}`)
+	})
+
+	mux.HandleFunc("/2fa/2/applications/HJ675435E3A6EA43432G5F37A635KJ8B/verifications", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"verifications": [{"msisdn": "%s", "verified": true, "verifiedAt": 1418364366000, "sentAt": 1418364246000}]}`, r.URL.Query().Get("msisdn"))
+	})
+
+	pin, err := client.SendPIN(&infobip.PINRequest{
+		ApplicationID: "HJ675435E3A6EA43432G5F37A635KJ8B",
+		MessageID:     "9C815F8AF3FAD6B7D4EBC4DA81F8F01E",
+		To:            "41793026727",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	res, err := client.VerifyPIN(pin.PinID, "0000")
+	if !infobip.IsWrongPIN(err) || infobip.IsPINExpired(err) || infobip.IsTooManyPINAttempts(err) {
+		t.Fatalf("expected wrong PIN error, got %v", err)
+	}
+
+	var pinErr *infobip.PINError
+	if !errors.As(err, &pinErr) || pinErr.AttemptsRemaining != 2 || res.AttemptsRemaining != 2 {
+		t.Fatalf("unexpected response %+v: %v", res, err)
+	}
+
+	var apiErr *infobip.APIError
+	if errors.As(err, &apiErr) {
+		t.Fatalf("wrong PIN is not an API error: %v", apiErr)
+	}
+
+	res, err = client.VerifyPIN(pin.PinID, "1598")
+	if err != nil || !res.Verified {
+		t.Fatalf("unexpected verification %+v: %v", res, err)
+	}
+
+	verifications, err := client.GetVerificationStatus("HJ675435E3A6EA43432G5F37A635KJ8B", "41793026727")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(verifications) != 1 || verifications[0].Msisdn != "41793026727" {
+		t.Fatalf("unexpected verifications: %+v", verifications)
+	}
+
+	if !verifications[0].VerifiedAt.Equal(time.Date(2014, 12, 12, 6, 6, 6, 0, time.UTC)) ||
+		!verifications[0].SentAt.Equal(time.Date(2014, 12, 12, 6, 4, 6, 0, time.UTC)) {
+		t.Fatalf("unexpected verification times: %+v", verifications[0])
+	}
+}
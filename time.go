@@ -0,0 +1,73 @@
+package infobip
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// timeLayout is the date format used by the API, e.g. 2015-02-12T09:58:20.323+0100
+const timeLayout = "2006-01-02T15:04:05.000-0700"
+
+// timeParseLayout accepts any number of fractional second digits.
+const timeParseLayout = "2006-01-02T15:04:05-0700"
+
+// Time is a thin wrapper around time.Time to support json marshal and unmarshal
+// of dates in the API format. Empty and null values are decoded as zero time.
+// Some endpoints send timestamps as JSON numbers instead, so a number in any
+// Time field is decoded as milliseconds since epoch. Marshaling always uses the API format.
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON is an implementation of Unmarshaler interface for the Time type.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var ms int64
+	if err := json.Unmarshal(data, &ms); err == nil {
+		t.Time = millisToTime(ms)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if len(s) < 1 {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	v, err := time.Parse(timeParseLayout, s)
+	if err != nil {
+		// fall back to the standard format
+		v, err = time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+	}
+	t.Time = v
+
+	return nil
+}
+
+// MarshalJSON is an implementation of Marshaler interface for the Time type.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.Format(timeLayout))
+}
+
+// millisToTime converts milliseconds since epoch to time, zero stays zero time.
+func millisToTime(ms int64) time.Time {
+	if ms < 1 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
@@ -0,0 +1,82 @@
+package infobip_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestTimeJSON(t *testing.T) {
+	var v struct {
+		At    infobip.Time `json:"at"`
+		Empty infobip.Time `json:"empty"`
+	}
+
+	err := json.Unmarshal([]byte(`{"at": "2015-02-12T09:58:20.323+0100", "empty": null}`), &v)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !v.At.Equal(time.Date(2015, 2, 12, 8, 58, 20, 323000000, time.UTC)) || !v.Empty.IsZero() {
+		t.Fatalf("unexpected times: %+v", v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if string(b) != `{"at":"2015-02-12T09:58:20.323+0100","empty":null}` {
+		t.Fatalf("unexpected json: %s", b)
+	}
+}
+
+func TestStatusGroup(t *testing.T) {
+	status := infobip.SentSmsStatus{GroupID: 4}
+	if !status.IsFinal() || status.IsSuccess() {
+		t.Fatal("expired status must be final and unsuccessful")
+	}
+
+	smsErr := infobip.SentSmsError{GroupID: 1, GroupName: infobip.ErrorGroupHandset, Permanent: true}
+	if !smsErr.IsFinal() || smsErr.IsSuccess() {
+		t.Fatal("permanent handset error must be final and unsuccessful")
+	}
+}
+
+func TestTimeJSONMillis(t *testing.T) {
+	var v struct {
+		At   infobip.Time `json:"at"`
+		Zero infobip.Time `json:"zero"`
+	}
+
+	err := json.Unmarshal([]byte(`{"at": 1418364366123, "zero": 0}`), &v)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !v.At.Equal(time.Date(2014, 12, 12, 6, 6, 6, 123000000, time.UTC)) || !v.Zero.IsZero() {
+		t.Fatalf("unexpected times: %+v", v)
+	}
+
+	// numbers are accepted in every Time field, e.g. of delivery reports
+	var report infobip.SentSmsReport
+	if err := json.Unmarshal([]byte(`{"sentAt": 1418364366123}`), &report); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !report.SentAt.Equal(v.At.Time) {
+		t.Fatalf("unexpected report time: %s", report.SentAt)
+	}
+
+	// but they are always written in the API format
+	b, err := json.Marshal(v.At)
+	if err != nil || string(b) != `"2014-12-12T06:06:06.123+0000"` {
+		t.Fatalf("unexpected json %s: %v", b, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"at": 1418364366.5}`), &v); err == nil {
+		t.Fatal("Should fail on fractional milliseconds")
+	}
+}
@@ -0,0 +1,194 @@
+package infobip
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// phoneNumberPattern matches E.164 numbers, the leading "+" is optional.
+var phoneNumberPattern = regexp.MustCompile(`^\+?[1-9][0-9]{1,14}$`)
+
+// numericSenderPattern matches numeric sender IDs.
+var numericSenderPattern = regexp.MustCompile(`^\+?[0-9]+$`)
+
+// Sender ID length limits.
+const (
+	minAlphanumericSenderLength = 3
+	maxAlphanumericSenderLength = 11
+	maxNumericSenderLength      = 16
+)
+
+// ValidationRules configures checks made before messages are sent.
+type ValidationRules struct {
+	// AllowEmptyText allows messages without text.
+	AllowEmptyText bool
+	// MaxDestinations limits the number of destinations of a single message.
+	MaxDestinations int
+}
+
+// DefaultValidationRules are used unless the client is configured otherwise.
+var DefaultValidationRules = ValidationRules{
+	AllowEmptyText:  false,
+	MaxDestinations: 1000,
+}
+
+// WithValidationRules overrides the checks made before messages are sent
+func WithValidationRules(rules ValidationRules) Option {
+	return func(c *Client) error {
+		c.validationRules = rules
+		return nil
+	}
+}
+
+// WithoutValidation disables the checks made before messages are sent,
+// leaving all validation to the API
+func WithoutValidation() Option {
+	return func(c *Client) error {
+		c.skipValidation = true
+		return nil
+	}
+}
+
+// FieldError describes an invalid field, Field is the path to the field, e.g. "to[1]".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error is an implementation of error interface for the FieldError type.
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors lists every violation found in a message.
+type ValidationErrors []FieldError
+
+// Error is an implementation of error interface for the ValidationErrors type.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// errorOrNil returns nil for an empty list, so the result can be returned as error.
+func (e ValidationErrors) errorOrNil() error {
+	if len(e) < 1 {
+		return nil
+	}
+	return e
+}
+
+// Validate checks the message with DefaultValidationRules.
+// It returns ValidationErrors listing every violation.
+func (s *SMS) Validate() error {
+	return s.ValidateWith(DefaultValidationRules)
+}
+
+// ValidateWith checks the message with the given rules.
+// It returns ValidationErrors listing every violation.
+func (s *SMS) ValidateWith(rules ValidationRules) error {
+	return s.validate(rules, "").errorOrNil()
+}
+
+// validate returns violations with field paths starting with the given prefix.
+func (s *SMS) validate(rules ValidationRules, prefix string) ValidationErrors {
+	var errs ValidationErrors
+
+	if msg := validateSender(s.From); len(msg) > 0 {
+		errs = append(errs, FieldError{Field: prefix + "from", Message: msg})
+	}
+
+	errs = append(errs, validateDestinations(s.To, rules, prefix)...)
+
+	if !rules.AllowEmptyText && len(s.Text) < 1 {
+		errs = append(errs, FieldError{Field: prefix + "text", Message: "text must be specified"})
+	}
+
+	return errs
+}
+
+// validate returns violations of the message with field paths starting with the given prefix.
+func (m *AdvancedMessage) validate(rules ValidationRules, prefix string) ValidationErrors {
+	var errs ValidationErrors
+
+	if msg := validateSender(m.From); len(msg) > 0 {
+		errs = append(errs, FieldError{Field: prefix + "from", Message: msg})
+	}
+
+	errs = append(errs, validateAdvancedDestinations(m.Destinations, rules, prefix)...)
+
+	if !rules.AllowEmptyText && len(m.Text) < 1 {
+		errs = append(errs, FieldError{Field: prefix + "text", Message: "text must be specified"})
+	}
+
+	return errs
+}
+
+// validateDestinations checks the number and format of destinations.
+func validateDestinations(destinations []string, rules ValidationRules, prefix string) ValidationErrors {
+	return validateNumbers(destinations, rules, prefix+"to", "")
+}
+
+// validateAdvancedDestinations is like validateDestinations for destinations of advanced requests.
+func validateAdvancedDestinations(destinations []Destination, rules ValidationRules, prefix string) ValidationErrors {
+	numbers := make([]string, len(destinations))
+	for i, d := range destinations {
+		numbers[i] = d.To
+	}
+	return validateNumbers(numbers, rules, prefix+"destinations", ".to")
+}
+
+// validateNumbers checks the number and format of phone numbers listed in the given field,
+// every number is reported with the index and the suffix of its field.
+func validateNumbers(numbers []string, rules ValidationRules, field string, suffix string) ValidationErrors {
+	var errs ValidationErrors
+
+	if len(numbers) < 1 {
+		errs = append(errs, FieldError{Field: field, Message: "at least one destination must be specified"})
+	}
+
+	if rules.MaxDestinations > 0 && len(numbers) > rules.MaxDestinations {
+		errs = append(errs, FieldError{
+			Field:   field,
+			Message: fmt.Sprintf("at most %d destinations are allowed", rules.MaxDestinations),
+		})
+	}
+
+	for i, to := range numbers {
+		if !phoneNumberPattern.MatchString(to) {
+			errs = append(errs, FieldError{
+				Field:   fmt.Sprintf("%s[%d]%s", field, i, suffix),
+				Message: fmt.Sprintf("%q is not a phone number in international format", to),
+			})
+		}
+	}
+
+	return errs
+}
+
+// validateSender checks sender ID length, empty sender is allowed
+// and replaced with the account default by the API.
+func validateSender(from string) string {
+	if len(from) < 1 {
+		return ""
+	}
+
+	if numericSenderPattern.MatchString(from) {
+		if len(strings.TrimPrefix(from, "+")) > maxNumericSenderLength {
+			return fmt.Sprintf("numeric sender must be at most %d digits long", maxNumericSenderLength)
+		}
+		return ""
+	}
+
+	length := utf8.RuneCountInString(from)
+	if length < minAlphanumericSenderLength || length > maxAlphanumericSenderLength {
+		return fmt.Sprintf("alphanumeric sender must be between %d and %d characters long",
+			minAlphanumericSenderLength, maxAlphanumericSenderLength)
+	}
+
+	return ""
+}
@@ -0,0 +1,93 @@
+package infobip_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestSMSValidate(t *testing.T) {
+	valid := []infobip.SMS{
+		{From: "InfoSMS", To: []string{"+12125551234"}, Text: "text"},
+		{From: "1234567890123456", To: []string{"385981178"}, Text: "text"},
+		{To: []string{"12125551234", "+385981178"}, Text: "text"},
+	}
+
+	for _, sms := range valid {
+		if err := sms.Validate(); err != nil {
+			t.Fatalf("%+v must be valid: %v", sms, err)
+		}
+	}
+
+	sms := infobip.SMS{
+		From: "very long sender",
+		To:   []string{"+12125551234", "not a number", "+0123"},
+	}
+
+	err := sms.Validate()
+
+	var errs infobip.ValidationErrors
+	if !errors.As(err, &errs) || !infobip.IsValidationError(err) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+
+	fields := []string{"from", "to[1]", "to[2]", "text"}
+	if len(errs) != len(fields) {
+		t.Fatalf("unexpected violations: %v", err)
+	}
+
+	for i, field := range fields {
+		if errs[i].Field != field {
+			t.Fatalf("expected violation of %s, got %s", field, errs[i].Field)
+		}
+	}
+
+	rules := infobip.ValidationRules{AllowEmptyText: true, MaxDestinations: 1}
+	sms = infobip.SMS{To: []string{"+12125551234", "+12125551235"}}
+	if err := sms.ValidateWith(rules); err == nil || err.Error() != "to: at most 1 destinations are allowed" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSendValidation(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	invalid := infobip.SMS{From: "x", To: []string{"+12125551234"}, Text: "text"}
+	if _, err := client.SendSMS(&invalid); !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	_, err := client.SendMultiSMS([]infobip.SMS{
+		{To: []string{"+12125551234"}, Text: "text"},
+		{To: []string{"+12125551234"}},
+	})
+	var errs infobip.ValidationErrors
+	if !errors.As(err, &errs) || errs[0].Field != "messages[1].text" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.SendAdvancedSMS(&infobip.AdvancedSMS{
+		Messages: []infobip.AdvancedMessage{
+			{Destinations: []infobip.Destination{{To: "+12125551234"}}, Text: "text"},
+			{From: "x", Destinations: []infobip.Destination{{To: "nobody"}}},
+		},
+	})
+	errs = nil
+	if !errors.As(err, &errs) || len(errs) != 3 ||
+		errs[0].Field != "messages[1].from" ||
+		errs[1].Field != "messages[1].destinations[0].to" ||
+		errs[2].Field != "messages[1].text" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	c, err := infobip.New(infobip.BaseURL(server.URL), infobip.WithoutValidation())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := c.SendSMS(&invalid); err != nil {
+		t.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,303 @@
+package infobip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const voiceEndpoint = "/tts/3/single"
+const multiVoiceEndpoint = "/tts/3/multi"
+const advancedVoiceEndpoint = "/tts/3/advanced"
+const voiceReportsEndpoint = "/tts/3/reports"
+const voiceLogsEndpoint = "/tts/3/logs"
+
+// VoiceGender is the gender of the voice reading the text.
+type VoiceGender string
+
+// Voice genders.
+const (
+	VoiceMale   VoiceGender = "male"
+	VoiceFemale VoiceGender = "female"
+)
+
+// MachineDetection sets what happens when an answering machine picks up the call.
+type MachineDetection string
+
+// Answering machine handling.
+const (
+	MachineDetectionHangup   MachineDetection = "hangup"
+	MachineDetectionContinue MachineDetection = "continue"
+)
+
+// Voice selects the voice reading the text, by name or by gender.
+type Voice struct {
+	Name   string      `json:"name,omitempty"`
+	Gender VoiceGender `json:"gender,omitempty"`
+}
+
+// VoiceMessage is a text-to-speech message that will be sent to array of destination addresses.
+// "Text" is read in the given "Language", or "AudioFileURL" is played instead.
+// "SpeechRate" is the reading speed, 1 is the normal speed.
+// "RepeatDtmf" is the DTMF code the callee enters to hear the message again.
+type VoiceMessage struct {
+	From             string           `json:"from,omitempty"`
+	To               []string         `json:"to"`
+	Text             string           `json:"text,omitempty"`
+	Language         string           `json:"language,omitempty"`
+	Voice            *Voice           `json:"voice,omitempty"`
+	SpeechRate       float64          `json:"speechRate,omitempty"`
+	AudioFileURL     string           `json:"audioFileUrl,omitempty"`
+	RepeatDtmf       string           `json:"repeatDtmf,omitempty"`
+	MachineDetection MachineDetection `json:"machineDetection,omitempty"`
+	SendAt           *Time            `json:"sendAt,omitempty"`
+}
+
+// Validate checks the message with DefaultValidationRules.
+// It returns ValidationErrors listing every violation.
+func (m *VoiceMessage) Validate() error {
+	return m.validate(DefaultValidationRules, "").errorOrNil()
+}
+
+func (m *VoiceMessage) validate(rules ValidationRules, prefix string) ValidationErrors {
+	errs := validateDestinations(m.To, rules, prefix)
+
+	if len(m.Text) < 1 && len(m.AudioFileURL) < 1 {
+		errs = append(errs, FieldError{Field: prefix + "text", Message: "text or audio file URL must be specified"})
+	}
+
+	return errs
+}
+
+// AdvancedVoiceMessage is a single message of the AdvancedVoice request.
+// The delivery options are the same as of AdvancedMessage.
+type AdvancedVoiceMessage struct {
+	From              string           `json:"from,omitempty"`
+	Destinations      []Destination    `json:"destinations"`
+	Text              string           `json:"text,omitempty"`
+	Language          string           `json:"language,omitempty"`
+	Voice             *Voice           `json:"voice,omitempty"`
+	SpeechRate        float64          `json:"speechRate,omitempty"`
+	AudioFileURL      string           `json:"audioFileUrl,omitempty"`
+	RepeatDtmf        string           `json:"repeatDtmf,omitempty"`
+	MachineDetection  MachineDetection `json:"machineDetection,omitempty"`
+	NotifyURL         string           `json:"notifyUrl,omitempty"`
+	NotifyContentType string           `json:"notifyContentType,omitempty"`
+	CallbackData      string           `json:"callbackData,omitempty"`
+	ValidityPeriod    int              `json:"validityPeriod,omitempty"`
+	SendAt            *Time            `json:"sendAt,omitempty"`
+}
+
+func (m *AdvancedVoiceMessage) validate(rules ValidationRules, prefix string) ValidationErrors {
+	errs := validateAdvancedDestinations(m.Destinations, rules, prefix)
+
+	if len(m.Text) < 1 && len(m.AudioFileURL) < 1 {
+		errs = append(errs, FieldError{Field: prefix + "text", Message: "text or audio file URL must be specified"})
+	}
+
+	return errs
+}
+
+// AdvancedVoice is a request sending many voice messages, each with its own text
+// and destinations. "BulkID" is an optional caller-provided ID of the whole request.
+type AdvancedVoice struct {
+	BulkID   string                 `json:"bulkId,omitempty"`
+	Messages []AdvancedVoiceMessage `json:"messages"`
+}
+
+func (m AdvancedVoiceMessage) destinations() []Destination {
+	return m.Destinations
+}
+
+// VoicePrice is a voice message price info: amount per second and currency.
+type VoicePrice struct {
+	PricePerSecond Amount `json:"pricePerSecond"`
+	Currency       string `json:"currency"`
+}
+
+// VoiceReport is a delivery report of a voice message, "Duration" is the call length in seconds.
+type VoiceReport struct {
+	BulkID       string        `json:"bulkId"`
+	MessageID    string        `json:"messageId"`
+	To           string        `json:"to"`
+	From         string        `json:"from"`
+	SentAt       Time          `json:"sentAt"`
+	DoneAt       Time          `json:"doneAt"`
+	Duration     int           `json:"duration"`
+	MccMnc       string        `json:"mccMnc"`
+	CallbackData string        `json:"callbackData"`
+	Price        VoicePrice    `json:"price"`
+	Status       SentSmsStatus `json:"status"`
+	Error        SentSmsError  `json:"error"`
+}
+
+// VoiceReportResponse contains a collection of reports, one per every voice message.
+type VoiceReportResponse struct {
+	Results []VoiceReport `json:"results"`
+}
+
+// VoiceLog is a log entry of a sent voice message.
+type VoiceLog struct {
+	BulkID    string        `json:"bulkId"`
+	MessageID string        `json:"messageId"`
+	To        string        `json:"to"`
+	From      string        `json:"from"`
+	Text      string        `json:"text"`
+	SentAt    Time          `json:"sentAt"`
+	DoneAt    Time          `json:"doneAt"`
+	Duration  int           `json:"duration"`
+	MccMnc    string        `json:"mccMnc"`
+	Price     VoicePrice    `json:"price"`
+	Status    SentSmsStatus `json:"status"`
+	Error     SentSmsError  `json:"error"`
+}
+
+// VoiceLogsResponse contains a collection of logs, one per every voice message.
+type VoiceLogsResponse struct {
+	Results []VoiceLog `json:"results"`
+}
+
+// SendVoiceMessage allows you to send a single text-to-speech message to array of destination addresses.
+func (c *Client) SendVoiceMessage(message *VoiceMessage) (*SmsResponse, error) {
+	return c.SendVoiceMessageContext(context.Background(), message)
+}
+
+// SendVoiceMessageContext is like SendVoiceMessage but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendVoiceMessageContext(ctx context.Context, message *VoiceMessage) (*SmsResponse, error) {
+
+	if !c.skipValidation {
+		if err := message.validate(c.validationRules, "").errorOrNil(); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := jsonBuffer(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, voiceEndpoint, payload, false)
+}
+
+// SendMultiVoiceMessage allows you to send many text-to-speech messages,
+// each with its own text, in one request.
+func (c *Client) SendMultiVoiceMessage(messages []VoiceMessage) (*SmsResponse, error) {
+	return c.SendMultiVoiceMessageContext(context.Background(), messages)
+}
+
+// SendMultiVoiceMessageContext is like SendMultiVoiceMessage but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendMultiVoiceMessageContext(ctx context.Context, messages []VoiceMessage) (*SmsResponse, error) {
+
+	if len(messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	// the whole batch is checked before anything is sent
+	if !c.skipValidation {
+		var errs ValidationErrors
+		for i, message := range messages {
+			errs = append(errs, message.validate(c.validationRules, fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(errs) > 0 {
+			return nil, errs
+		}
+	}
+
+	payload, err := jsonBuffer(struct {
+		Messages []VoiceMessage `json:"messages"`
+	}{messages})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, multiVoiceEndpoint, payload, false)
+}
+
+// SendAdvancedVoiceMessage allows you to send many text-to-speech messages in one request,
+// every message with its own text, destinations and delivery options.
+func (c *Client) SendAdvancedVoiceMessage(voice *AdvancedVoice) (*SmsResponse, error) {
+	return c.SendAdvancedVoiceMessageContext(context.Background(), voice)
+}
+
+// SendAdvancedVoiceMessageContext is like SendAdvancedVoiceMessage but uses the given context
+// for the underlying HTTP request.
+func (c *Client) SendAdvancedVoiceMessageContext(ctx context.Context, voice *AdvancedVoice) (*SmsResponse, error) {
+
+	if len(voice.Messages) < 1 {
+		return nil, errors.New("at least one message must be specified")
+	}
+
+	// the whole batch is checked before anything is sent
+	if !c.skipValidation {
+		var errs ValidationErrors
+		for i, message := range voice.Messages {
+			errs = append(errs, message.validate(c.validationRules, fmt.Sprintf("messages[%d].", i))...)
+		}
+		if len(errs) > 0 {
+			return nil, errs
+		}
+	}
+
+	payload, err := jsonBuffer(voice)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sendMessages(ctx, advancedVoiceEndpoint, payload, hasMessageIDs(voice.Messages))
+}
+
+// GetVoiceDeliveryReports allows you to get one time delivery reports for sent voice messages.
+func (c *Client) GetVoiceDeliveryReports(query DeliveryReportQuery) (*VoiceReportResponse, error) {
+	return c.GetVoiceDeliveryReportsContext(context.Background(), query)
+}
+
+// GetVoiceDeliveryReportsContext is like GetVoiceDeliveryReports but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetVoiceDeliveryReportsContext(ctx context.Context, query DeliveryReportQuery) (*VoiceReportResponse, error) {
+
+	if query.Limit < 0 {
+		return nil, errors.New("limit must not be negative")
+	}
+
+	res := VoiceReportResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+voiceReportsEndpoint+query.encode(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetVoiceLogs allows you to get logs of sent voice messages matching the query,
+// the filters are the same as of SMS logs.
+func (c *Client) GetVoiceLogs(query SmsLogsQuery) (*VoiceLogsResponse, error) {
+	return c.GetVoiceLogsContext(context.Background(), query)
+}
+
+// GetVoiceLogsContext is like GetVoiceLogs but uses the given context
+// for the underlying HTTP request.
+func (c *Client) GetVoiceLogsContext(ctx context.Context, query SmsLogsQuery) (*VoiceLogsResponse, error) {
+
+	if query.Limit < 0 || query.Limit > maxLogsLimit {
+		return nil, errors.Errorf("limit must be between 0 and %d", maxLogsLimit)
+	}
+
+	res := VoiceLogsResponse{}
+	err := c.doRequest(ctx, "GET", c.baseURL+voiceLogsEndpoint+query.encode(), nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// NewVoiceReportHandler returns an http.Handler receiving delivery reports
+// pushed to the notifyUrl of sent voice messages. Reports may arrive more than once,
+// see WebhookOption.
+func NewVoiceReportHandler(callback func(context.Context, VoiceReport) error, opts ...WebhookOption) http.Handler {
+	return newWebhook(callback, opts...)
+}
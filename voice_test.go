@@ -0,0 +1,124 @@
+package infobip_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func TestSendVoiceMessage(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	var payload map[string]interface{}
+	respond := func(w http.ResponseWriter, r *http.Request) {
+		payload = map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("sms-sent-response.json"))
+	}
+	mux.HandleFunc("/tts/3/single", respond)
+	mux.HandleFunc("/tts/3/multi", respond)
+	mux.HandleFunc("/tts/3/advanced", respond)
+
+	message := infobip.VoiceMessage{
+		From:             "41793026700",
+		To:               []string{"41793026727"},
+		Text:             "Test Voice message.",
+		Language:         "en",
+		Voice:            &infobip.Voice{Gender: infobip.VoiceFemale},
+		RepeatDtmf:       "123#",
+		MachineDetection: infobip.MachineDetectionHangup,
+	}
+
+	if _, err := client.SendVoiceMessage(&message); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	voice := payload["voice"].(map[string]interface{})
+	if voice["gender"] != "female" || payload["machineDetection"] != "hangup" || payload["repeatDtmf"] != "123#" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+
+	if _, err := client.SendMultiVoiceMessage([]infobip.VoiceMessage{message, {To: []string{"41793026727"}}}); !infobip.IsValidationError(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	_, err := client.SendAdvancedVoiceMessage(&infobip.AdvancedVoice{
+		BulkID: "voice-bulk",
+		Messages: []infobip.AdvancedVoiceMessage{
+			{
+				Destinations: []infobip.Destination{{To: "41793026727"}},
+				AudioFileURL: "https://example.com/message.mp3",
+				NotifyURL:    "https://example.com/voice-reports",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if payload["bulkId"] != "voice-bulk" {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+
+	_, err = client.SendAdvancedVoiceMessage(&infobip.AdvancedVoice{
+		Messages: []infobip.AdvancedVoiceMessage{
+			{Destinations: []infobip.Destination{{To: "not a number"}}},
+		},
+	})
+
+	var errs infobip.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Field != "messages[0].destinations[0].to" || errs[1].Field != "messages[0].text" {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+}
+
+func TestVoiceReports(t *testing.T) {
+	tearDown := setup()
+	defer tearDown()
+
+	mux.HandleFunc("/tts/3/reports", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("voice-report-response.json"))
+	})
+
+	mux.HandleFunc("/tts/3/logs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, fixture("voice-report-response.json"))
+	})
+
+	reports, err := client.GetVoiceDeliveryReports(infobip.DeliveryReportQuery{Limit: 10})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := reports.Results[0]
+	if report.Duration != 10 || report.Price.PricePerSecond.String() != "0.01" || !report.Status.IsSuccess() {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	logs, err := client.GetVoiceLogs(infobip.SmsLogsQuery{GeneralStatus: infobip.StatusDelivered})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(logs.Results) != 1 || logs.Results[0].SentAt.IsZero() {
+		t.Fatalf("unexpected logs: %+v", logs)
+	}
+
+	var pushed []infobip.VoiceReport
+	h := infobip.NewVoiceReportHandler(func(ctx context.Context, report infobip.VoiceReport) error {
+		pushed = append(pushed, report)
+		return nil
+	})
+
+	if code := push(h, fixture("voice-report-response.json"), nil); code != http.StatusOK || len(pushed) != 1 {
+		t.Fatalf("unexpected status %d for %d reports", code, len(pushed))
+	}
+}
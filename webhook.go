@@ -0,0 +1,164 @@
+package infobip
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// defaultMaxBodySize is the default limit of pushed request bodies.
+const defaultMaxBodySize = 1 << 20
+
+// webhookConfig contains settings shared by all webhook handlers.
+type webhookConfig struct {
+	maxBodySize int64
+	username    string
+	password    string
+	networks    []*net.IPNet
+}
+
+// WebhookOption is a functional option for configuring webhook handlers.
+// Webhook handlers call back once per pushed result, when a callback fails
+// the whole push is answered with a server error and sent again, so callbacks
+// should tolerate duplicates
+type WebhookOption func(*webhookConfig)
+
+// WithMaxBodySize limits the size of pushed request bodies, 1MB by default.
+// Sizes below one byte keep the default
+func WithMaxBodySize(size int64) WebhookOption {
+	return func(c *webhookConfig) {
+		if size < 1 {
+			size = defaultMaxBodySize
+		}
+		c.maxBodySize = size
+	}
+}
+
+// WithWebhookBasicAuth requires pushed requests to have the given HTTP Basic credentials
+func WithWebhookBasicAuth(username, password string) WebhookOption {
+	return func(c *webhookConfig) {
+		c.username = username
+		c.password = password
+	}
+}
+
+// WithAllowedNetworks accepts pushed requests only from the given networks.
+// The client address is taken from the connection, so the handler must not
+// be put behind a proxy when this option is used
+func WithAllowedNetworks(networks ...*net.IPNet) WebhookOption {
+	return func(c *webhookConfig) {
+		c.networks = append(c.networks, networks...)
+	}
+}
+
+// webhook is an http.Handler decoding pushed JSON payloads and
+// calling back once per pushed result. It's shared by all push
+// notification handlers.
+type webhook[T any] struct {
+	config   webhookConfig
+	callback func(context.Context, T) error
+}
+
+// resultsPayload is the body of pushes made by the API.
+type resultsPayload[T any] struct {
+	Results []T `json:"results"`
+}
+
+func newWebhook[T any](callback func(context.Context, T) error, opts ...WebhookOption) *webhook[T] {
+	h := &webhook[T]{
+		config: webhookConfig{
+			maxBodySize: defaultMaxBodySize,
+		},
+		callback: callback,
+	}
+
+	for _, option := range opts {
+		option(&h.config)
+	}
+
+	return h
+}
+
+// ServeHTTP is an implementation of http.Handler interface for the webhook type.
+// Callback errors are answered with a server error, so the API pushes the payload again.
+func (h *webhook[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !h.allowed(r) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="infobip"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	payload := resultsPayload[T]{}
+	body := http.MaxBytesReader(w, r.Body, h.config.maxBodySize)
+	if err := json.NewDecoder(body).Decode(&payload); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	for _, result := range payload.Results {
+		if err := h.callback(r.Context(), result); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// allowed reports whether the request comes from an allowed network.
+func (h *webhook[T]) allowed(r *http.Request) bool {
+	if len(h.config.networks) < 1 {
+		return true
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(host)
+	for _, network := range h.config.networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// authorized reports whether the request has the expected credentials.
+func (h *webhook[T]) authorized(r *http.Request) bool {
+	if len(h.config.username) < 1 && len(h.config.password) < 1 {
+		return true
+	}
+
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+
+	usernameMatch := subtle.ConstantTimeCompare([]byte(username), []byte(h.config.username))
+	passwordMatch := subtle.ConstantTimeCompare([]byte(password), []byte(h.config.password))
+
+	return usernameMatch&passwordMatch == 1
+}
@@ -0,0 +1,108 @@
+package infobip_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gaart/go-infobip"
+)
+
+func push(h http.Handler, body string, prepare func(*http.Request)) int {
+	r := httptest.NewRequest("POST", "/reports", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if prepare != nil {
+		prepare(r)
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w.Code
+}
+
+func TestDeliveryReportHandler(t *testing.T) {
+	var reports []infobip.SentSmsReport
+	h := infobip.NewDeliveryReportHandler(func(ctx context.Context, report infobip.SentSmsReport) error {
+		reports = append(reports, report)
+		return nil
+	})
+
+	if code := push(h, fixture("delivery-report-response.json"), nil); code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", code)
+	}
+
+	if len(reports) != 1 || reports[0].MessageID != "ff4804ef-6ab6-4abd-984d-ab3b1387e852" {
+		t.Fatalf("unexpected reports: %+v", reports)
+	}
+
+	if code := push(h, "{", nil); code != http.StatusBadRequest {
+		t.Fatalf("unexpected status on malformed body: %d", code)
+	}
+}
+
+func TestDeliveryReportHandlerFailure(t *testing.T) {
+	h := infobip.NewDeliveryReportHandler(func(ctx context.Context, report infobip.SentSmsReport) error {
+		return errors.New("storage is down")
+	})
+
+	if code := push(h, fixture("delivery-report-response.json"), nil); code != http.StatusInternalServerError {
+		t.Fatalf("unexpected status: %d", code)
+	}
+}
+
+func TestWebhookOptions(t *testing.T) {
+	_, network, _ := net.ParseCIDR("10.0.0.0/8")
+	h := infobip.NewDeliveryReportHandler(
+		func(ctx context.Context, report infobip.SentSmsReport) error {
+			return nil
+		},
+		infobip.WithMaxBodySize(2048),
+		infobip.WithWebhookBasicAuth("user", "pass"),
+		infobip.WithAllowedNetworks(network),
+	)
+
+	body := fixture("delivery-report-response.json")
+	allowed := func(r *http.Request) {
+		r.RemoteAddr = "10.1.2.3:1234"
+		r.SetBasicAuth("user", "pass")
+	}
+
+	if code := push(h, body, allowed); code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", code)
+	}
+
+	code := push(h, body, func(r *http.Request) {
+		r.RemoteAddr = "192.168.1.1:1234"
+		r.SetBasicAuth("user", "pass")
+	})
+	if code != http.StatusForbidden {
+		t.Fatalf("unexpected status for disallowed address: %d", code)
+	}
+
+	code = push(h, body, func(r *http.Request) {
+		r.RemoteAddr = "10.1.2.3:1234"
+		r.SetBasicAuth("user", "wrong")
+	})
+	if code != http.StatusUnauthorized {
+		t.Fatalf("unexpected status for wrong credentials: %d", code)
+	}
+
+	if code := push(h, strings.Repeat(" ", 2048)+body, allowed); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("unexpected status for large body: %d", code)
+	}
+}
+
+func TestWebhookInvalidMaxBodySize(t *testing.T) {
+	h := infobip.NewDeliveryReportHandler(func(ctx context.Context, report infobip.SentSmsReport) error {
+		return nil
+	}, infobip.WithMaxBodySize(0))
+
+	if code := push(h, fixture("delivery-report-response.json"), nil); code != http.StatusOK {
+		t.Fatalf("unexpected status: %d", code)
+	}
+}